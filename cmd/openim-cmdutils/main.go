@@ -52,7 +52,23 @@ func main() {
 	// openIM clear msg --userID=xxx --beginSeq=100 --limit=10
 	// openIM clear msg --superGroupID=xxx --beginSeq=100 --limit=10
 	// openIM clear msg --clearAll
-	msgUtilsCmd.AddCommand(&getCmd.Command, &fixCmd.Command, &clearCmd.Command)
+
+	inspectCmd := cmd.NewInspectCmd()
+	replayCmd := cmd.NewReplayCmd()
+	discardCmd := cmd.NewDiscardCmd()
+	inspectCmd.AddCommand(cmd.NewDeadLetterCmd().InspectDeadLetterCmd())
+	replayCmd.AddCommand(cmd.NewDeadLetterCmd().ReplayDeadLetterCmd())
+	discardCmd.AddCommand(cmd.NewDeadLetterCmd().DiscardDeadLetterCmd())
+	for _, deadLetterCmd := range []*cmd.MsgUtilsCmd{inspectCmd.MsgUtilsCmd, replayCmd.MsgUtilsCmd, discardCmd.MsgUtilsCmd} {
+		deadLetterCmd.AddStageFlag()
+		deadLetterCmd.AddLimitFlag()
+		deadLetterCmd.AddConfFlag()
+	}
+	// openIM inspect deadLetter --stage=msgToMongo --limit=10 --config_folder_path=../config
+	// openIM replay deadLetter --stage=msgToMongo --limit=10
+	// openIM discard deadLetter --stage=msgToRedis --limit=1
+	msgUtilsCmd.AddCommand(&getCmd.Command, &fixCmd.Command, &clearCmd.Command,
+		&inspectCmd.Command, &replayCmd.Command, &discardCmd.Command)
 	if err := msgUtilsCmd.Execute(); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,64 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// openim-standalone 在同一进程中运行msg、push和msgtransfer, 消息队列使用进程内实现, 不依赖kafka.
+// 用于单机部署和集成测试, 其余服务仍单独启动.
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/OpenIMSDK/Open-IM-Server/internal/msgtransfer"
+	"github.com/OpenIMSDK/Open-IM-Server/internal/push"
+	"github.com/OpenIMSDK/Open-IM-Server/internal/rpc/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/cmd"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mq"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/startrpc"
+)
+
+const standaloneStartTimeout = time.Second * 30
+
+func main() {
+	standaloneCmd := cmd.NewRootCmd("standalone")
+	standaloneCmd.Command.Run = func(*cobra.Command, []string) {}
+	if err := standaloneCmd.Execute(); err != nil {
+		panic(err.Error())
+	}
+	config.Config.MQ.Type = mq.TypeMemory
+	errCh := make(chan error, 3)
+	go func() {
+		errCh <- startrpc.Start(config.Config.RpcPort.OpenImPushPort[0], config.Config.RpcRegisterName.OpenImPushName,
+			config.Config.Prometheus.PushPrometheusPort[0], push.Start)
+	}()
+	go func() {
+		errCh <- msgtransfer.StartTransfer(config.Config.Prometheus.MessageTransferPrometheusPort[0])
+	}()
+	// 消费者订阅后再启动msg, 启动阶段的消息也会补发给之后订阅的消费组
+	if !mq.WaitMemorySubscribed(standaloneStartTimeout, config.Config.Kafka.LatestMsgToRedis.Topic,
+		config.Config.Kafka.MsgToMongo.Topic, config.Config.Kafka.MsgToPush.Topic) {
+		fmt.Println("consumers not subscribed before timeout, start msg anyway")
+	}
+	go func() {
+		errCh <- startrpc.Start(config.Config.RpcPort.OpenImMessagePort[0], config.Config.RpcRegisterName.OpenImMsgName,
+			config.Config.Prometheus.MessagePrometheusPort[0], msg.Start)
+	}()
+	// 任意一个服务退出(出错或收到SIGTERM后完成drain)时整个进程退出
+	if err := <-errCh; err != nil {
+		panic(err.Error())
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/local"
+)
+
+// LocalObjectApi 处理本地存储签名链接的上传和下载.
+type LocalObjectApi struct {
+	local *local.Local
+}
+
+func NewLocalObjectApi(l *local.Local) *LocalObjectApi {
+	return &LocalObjectApi{local: l}
+}
+
+func (o *LocalObjectApi) verify(c *gin.Context) (string, url.Values, bool) {
+	query := c.Request.URL.Query()
+	name, err := o.local.Verify(c.Request.Method, c.Param("name"), query)
+	if err != nil {
+		if errors.Is(err, local.ErrInvalidName) {
+			c.String(http.StatusBadRequest, err.Error())
+		} else {
+			c.String(http.StatusForbidden, err.Error())
+		}
+		return "", nil, false
+	}
+	return name, query, true
+}
+
+func (o *LocalObjectApi) PutObject(c *gin.Context) {
+	name, query, ok := o.verify(c)
+	if !ok {
+		return
+	}
+	var etag string
+	if uploadID := query.Get("uploadId"); uploadID != "" {
+		partNumber, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil {
+			c.String(http.StatusBadRequest, "invalid partNumber")
+			return
+		}
+		part, err := o.local.UploadPart(c, uploadID, name, partNumber, c.Request.Body)
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		etag = part.ETag
+	} else {
+		info, err := o.local.PutObject(c, name, c.Request.Body)
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		etag = info.ETag
+	}
+	c.Header("ETag", strconv.Quote(etag))
+	c.Status(http.StatusOK)
+}
+
+func (o *LocalObjectApi) GetObject(c *gin.Context) {
+	name, query, ok := o.verify(c)
+	if !ok {
+		return
+	}
+	file, info, err := o.local.Open(c, name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			c.String(http.StatusNotFound, err.Error())
+		} else {
+			c.String(http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	defer file.Close()
+	if contentType := query.Get("response-content-type"); contentType != "" {
+		c.Header("Content-Type", contentType)
+	}
+	if filename := query.Get("filename"); filename != "" {
+		c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	}
+	c.Header("ETag", strconv.Quote(info.ETag))
+	http.ServeContent(c.Writer, c.Request, info.Key, info.LastModified, file)
+}
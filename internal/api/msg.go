@@ -125,6 +125,74 @@ func (m *MessageApi) RevokeMsg(c *gin.Context) {
 	a2r.Call(msg.MsgClient.RevokeMsg, m.Client, c)
 }
 
+func (m *MessageApi) EditMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.EditMsg, m.Client, c)
+}
+
+func (m *MessageApi) GetScheduledMsgs(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetScheduledMsgs, m.Client, c)
+}
+
+func (m *MessageApi) SetScheduledMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.SetScheduledMsg, m.Client, c)
+}
+
+func (m *MessageApi) CancelScheduledMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.CancelScheduledMsg, m.Client, c)
+}
+
+func (m *MessageApi) SetRetentionPolicy(c *gin.Context) {
+	a2r.Call(msg.MsgClient.SetRetentionPolicy, m.Client, c)
+}
+
+func (m *MessageApi) DeleteRetentionPolicy(c *gin.Context) {
+	a2r.Call(msg.MsgClient.DeleteRetentionPolicy, m.Client, c)
+}
+
+func (m *MessageApi) GetRetentionPolicies(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetRetentionPolicies, m.Client, c)
+}
+
+func (m *MessageApi) SetMessageReactionExtensions(c *gin.Context) {
+	a2r.Call(msg.MsgClient.SetMessageReactionExtensions, m.Client, c)
+}
+
+func (m *MessageApi) DeleteMessageReactionExtensions(c *gin.Context) {
+	a2r.Call(msg.MsgClient.DeleteMessageReactionExtensions, m.Client, c)
+}
+
+func (m *MessageApi) GetMessagesReactionExtensions(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetMessagesReactionExtensions, m.Client, c)
+}
+
+func (m *MessageApi) GetGroupMsgReadStatus(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetGroupMsgReadStatus, m.Client, c)
+}
+
+func (m *MessageApi) PinMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.PinMsg, m.Client, c)
+}
+
+func (m *MessageApi) UnpinMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.UnpinMsg, m.Client, c)
+}
+
+func (m *MessageApi) GetPinnedMsgs(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetPinnedMsgs, m.Client, c)
+}
+
+func (m *MessageApi) GetThreadsInfo(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetThreadsInfo, m.Client, c)
+}
+
+func (m *MessageApi) PullThreadMsgs(c *gin.Context) {
+	a2r.Call(msg.MsgClient.PullThreadMsgs, m.Client, c)
+}
+
+func (m *MessageApi) SetThreadHasReadSeq(c *gin.Context) {
+	a2r.Call(msg.MsgClient.SetThreadHasReadSeq, m.Client, c)
+}
+
 func (m *MessageApi) MarkMsgsAsRead(c *gin.Context) {
 	a2r.Call(msg.MsgClient.MarkMsgsAsRead, m.Client, c)
 }
@@ -217,6 +285,15 @@ func (m *MessageApi) SendMessage(c *gin.Context) {
 		return
 	}
 	sendMsgReq.MsgData.RecvID = req.RecvID
+	if req.SendTime > utils.GetCurrentTimestampByMill() {
+		respPb, err := m.Client.ScheduleMsg(c, &msg.ScheduleMsgReq{MsgData: sendMsgReq.MsgData, SendTime: req.SendTime})
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		apiresp.GinSuccess(c, respPb)
+		return
+	}
 	var status int
 	respPb, err := m.Client.SendMsg(c, sendMsgReq)
 	if err != nil {
@@ -300,6 +377,14 @@ func (m *MessageApi) CheckMsgIsSendSuccess(c *gin.Context) {
 	a2r.Call(msg.MsgClient.GetSendMsgStatus, m.Client, c)
 }
 
+func (m *MessageApi) MsgDeliveryAck(c *gin.Context) {
+	a2r.Call(msg.MsgClient.MsgDeliveryAck, m.Client, c)
+}
+
+func (m *MessageApi) GetMsgDeliveryStatus(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetMsgDeliveryStatus, m.Client, c)
+}
+
 func (m *MessageApi) GetUsersOnlineStatus(c *gin.Context) {
 	a2r.Call(msg.MsgClient.GetSendMsgStatus, m.Client, c)
 }
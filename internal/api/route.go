@@ -25,6 +25,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/local"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mw"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/prome"
@@ -49,6 +50,7 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 	conversationRpc := rpcclient.NewConversation(discov)
 	authRpc := rpcclient.NewAuth(discov)
 	thirdRpc := rpcclient.NewThird(discov)
+	rtcRpc := rpcclient.NewRtc(discov)
 
 	u := NewUserApi(*userRpc)
 	m := NewMessageApi(messageRpc, userRpc)
@@ -71,6 +73,8 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 		userRouterGroup.POST("/get_users", ParseToken, u.GetUsers)
 		userRouterGroup.POST("/get_users_online_status", ParseToken, u.GetUsersOnlineStatus)
 		userRouterGroup.POST("/get_users_online_token_detail", ParseToken, u.GetUsersOnlineTokenDetail)
+		userRouterGroup.POST("/set_user_presence", ParseToken, u.SetUserPresence)
+		userRouterGroup.POST("/get_users_presence", ParseToken, u.GetUsersPresence)
 	}
 	// friend routing group
 	friendRouterGroup := r.Group("/friend", ParseToken)
@@ -145,18 +149,53 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 		objectGroup.POST("/access_url", t.AccessURL)
 		objectGroup.GET("/*name", t.ObjectRedirect)
 	}
+	// 本地对象存储的签名链接, 不需要token
+	if config.Config.Object.Enable == "local" {
+		l, err := local.NewLocal()
+		if err != nil {
+			panic(err)
+		}
+		o := NewLocalObjectApi(l)
+		localObjectGroup := r.Group("/local_object")
+		localObjectGroup.PUT("/*name", o.PutObject)
+		localObjectGroup.GET("/*name", o.GetObject)
+		localObjectGroup.HEAD("/*name", o.GetObject)
+	}
+	// Rtc service
+	rtcGroup := r.Group("/rtc", ParseToken)
+	{
+		t := NewRtcApi(*rtcRpc)
+		rtcGroup.POST("/signal_get_pending_invitations", t.SignalGetPendingInvitations)
+	}
 	// Message
 	msgGroup := r.Group("/msg", ParseToken)
 	{
 		msgGroup.POST("/newest_seq", m.GetSeq)
 		msgGroup.POST("/search_msg", m.SearchMsg)
 		msgGroup.POST("/send_msg", m.SendMessage)
+		msgGroup.POST("/get_scheduled_msgs", m.GetScheduledMsgs)
+		msgGroup.POST("/set_scheduled_msg", m.SetScheduledMsg)
+		msgGroup.POST("/cancel_scheduled_msg", m.CancelScheduledMsg)
+		msgGroup.POST("/set_retention_policy", m.SetRetentionPolicy)
+		msgGroup.POST("/delete_retention_policy", m.DeleteRetentionPolicy)
+		msgGroup.POST("/get_retention_policies", m.GetRetentionPolicies)
 		msgGroup.POST("/pull_msg_by_seq", m.PullMsgBySeqs)
 		msgGroup.POST("/revoke_msg", m.RevokeMsg)
+		msgGroup.POST("/edit_msg", m.EditMsg)
+		msgGroup.POST("/set_message_reaction_extensions", m.SetMessageReactionExtensions)
+		msgGroup.POST("/delete_message_reaction_extensions", m.DeleteMessageReactionExtensions)
+		msgGroup.POST("/get_messages_reaction_extensions", m.GetMessagesReactionExtensions)
+		msgGroup.POST("/pin_msg", m.PinMsg)
+		msgGroup.POST("/unpin_msg", m.UnpinMsg)
+		msgGroup.POST("/get_pinned_msgs", m.GetPinnedMsgs)
 		msgGroup.POST("/mark_msgs_as_read", m.MarkMsgsAsRead)
 		msgGroup.POST("/mark_conversation_as_read", m.MarkConversationAsRead)
 		msgGroup.POST("/get_conversations_has_read_and_max_seq", m.GetConversationsHasReadAndMaxSeq)
 		msgGroup.POST("/set_conversation_has_read_seq", m.SetConversationHasReadSeq)
+		msgGroup.POST("/get_group_msg_read_status", m.GetGroupMsgReadStatus)
+		msgGroup.POST("/get_threads_info", m.GetThreadsInfo)
+		msgGroup.POST("/pull_thread_msgs", m.PullThreadMsgs)
+		msgGroup.POST("/set_thread_has_read_seq", m.SetThreadHasReadSeq)
 
 		msgGroup.POST("/clear_conversation_msg", m.ClearConversationsMsg)
 		msgGroup.POST("/user_clear_all_msg", m.UserClearAllMsg)
@@ -166,6 +205,8 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 
 		msgGroup.POST("/batch_send_msg", m.BatchSendMsg)
 		msgGroup.POST("/check_msg_is_send_success", m.CheckMsgIsSendSuccess)
+		msgGroup.POST("/msg_delivery_ack", m.MsgDeliveryAck)
+		msgGroup.POST("/get_msg_delivery_status", m.GetMsgDeliveryStatus)
 	}
 	// Conversation
 	conversationGroup := r.Group("/conversation", ParseToken)
@@ -0,0 +1,33 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/a2r"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/rtc"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/rpcclient"
+)
+
+type RtcApi rpcclient.Rtc
+
+func NewRtcApi(client rpcclient.Rtc) RtcApi {
+	return RtcApi(client)
+}
+
+func (r *RtcApi) SignalGetPendingInvitations(c *gin.Context) {
+	a2r.Call(rtc.RtcServiceClient.SignalGetPendingInvitations, r.Client, c)
+}
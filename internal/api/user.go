@@ -62,6 +62,14 @@ func (u *UserApi) GetUsers(c *gin.Context) {
 	a2r.Call(user.UserClient.GetPaginationUsers, u.Client, c)
 }
 
+func (u *UserApi) SetUserPresence(c *gin.Context) {
+	a2r.Call(user.UserClient.SetUserPresence, u.Client, c)
+}
+
+func (u *UserApi) GetUsersPresence(c *gin.Context) {
+	a2r.Call(user.UserClient.GetUsersPresence, u.Client, c)
+}
+
 func (u *UserApi) GetUsersOnlineStatus(c *gin.Context) {
 	var req msggateway.GetUsersOnlineStatusReq
 	if err := c.BindJSON(&req); err != nil {
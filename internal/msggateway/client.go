@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sync"
+	"time"
 
+	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/apiresp"
@@ -36,6 +38,7 @@ var (
 	ErrNotSupportMessageProtocol = errors.New("not support message protocol")
 	ErrClientClosed              = errors.New("client actively close the connection")
 	ErrPanic                     = errors.New("panic error")
+	ErrGatewayDraining           = errors.New("gateway is draining")
 )
 
 const (
@@ -64,6 +67,7 @@ type Client struct {
 	conn           LongConn
 	PlatformID     int    `json:"platformID"`
 	IsCompress     bool   `json:"isCompress"`
+	Encoding       string `json:"encoding"`
 	UserID         string `json:"userID"`
 	IsBackground   bool   `json:"isBackground"`
 	ctx            *UserConnContext
@@ -71,6 +75,11 @@ type Client struct {
 	closed         bool
 	closedErr      error
 	token          string
+	encoder        Encoder
+	session        *clientSession
+	limiter        *rate.Limiter
+	registered     chan struct{} // 事件循环注册完成后关闭
+	routeFlushed   chan struct{} // 注册时的路由写入redis后关闭
 }
 
 func newClient(ctx *UserConnContext, conn LongConn, isCompress bool) *Client {
@@ -81,6 +90,7 @@ func newClient(ctx *UserConnContext, conn LongConn, isCompress bool) *Client {
 		IsCompress: isCompress,
 		UserID:     ctx.GetUserID(),
 		ctx:        ctx,
+		encoder:    NewGobEncoder(),
 	}
 }
 
@@ -88,6 +98,8 @@ func (c *Client) ResetClient(
 	ctx *UserConnContext,
 	conn LongConn,
 	isBackground, isCompress bool,
+	encoding string,
+	encoder Encoder,
 	longConnServer LongConnServer,
 	token string,
 ) {
@@ -95,6 +107,8 @@ func (c *Client) ResetClient(
 	c.conn = conn
 	c.PlatformID = utils.StringToInt(ctx.GetPlatformID())
 	c.IsCompress = isCompress
+	c.Encoding = encoding
+	c.encoder = encoder
 	c.IsBackground = isBackground
 	c.UserID = ctx.GetUserID()
 	c.ctx = ctx
@@ -103,6 +117,30 @@ func (c *Client) ResetClient(
 	c.closed = false
 	c.closedErr = nil
 	c.token = token
+	c.session = newClientSession()
+	c.limiter = nil
+	c.registered = make(chan struct{})
+	c.routeFlushed = nil
+}
+
+// waitRoute 等待注册时的路由写入redis后再读取请求, 连接后的同步请求不会早于路由生效,
+// 期间按离线处理的消息由同步取回.
+func (c *Client) waitRoute() {
+	timer := time.NewTimer(routeFlushWait)
+	defer timer.Stop()
+	select {
+	case <-c.registered:
+	case <-timer.C:
+		return
+	}
+	if c.routeFlushed == nil {
+		return
+	}
+	select {
+	case <-c.routeFlushed:
+	case <-timer.C:
+		log.ZWarn(c.ctx, "wait route flush timeout", nil, "userID", c.UserID)
+	}
 }
 
 func (c *Client) pongHandler(_ string) error {
@@ -141,8 +179,17 @@ func (c *Client) readMessage() {
 				return
 			}
 		case MessageText:
-			c.closedErr = ErrNotSupportMessageProtocol
-			return
+			// text frames are only meaningful for clients that negotiated json encoding
+			if c.Encoding != JsonEncodingProtocol {
+				c.closedErr = ErrNotSupportMessageProtocol
+				return
+			}
+			_ = c.conn.SetReadDeadline(pongWait)
+			parseDataErr := c.handleMessage(message)
+			if parseDataErr != nil {
+				c.closedErr = parseDataErr
+				return
+			}
 		case PingMessage:
 			err := c.writePongMsg()
 			log.ZError(c.ctx, "writePongMsg", err)
@@ -163,7 +210,7 @@ func (c *Client) handleMessage(message []byte) error {
 		}
 	}
 	var binaryReq Req
-	err := c.longConnServer.Decode(message, &binaryReq)
+	err := c.encoder.Decode(message, &binaryReq)
 	if err != nil {
 		return utils.Wrap(err, "")
 	}
@@ -177,6 +224,17 @@ func (c *Client) handleMessage(message []byte) error {
 		[]string{binaryReq.OperationID, binaryReq.SendID, constant.PlatformIDToName(c.PlatformID), c.ctx.GetConnID()},
 	)
 	log.ZDebug(ctx, "gateway req message", "req", binaryReq.String())
+	if !c.longConnServer.startRequest() {
+		return ErrGatewayDraining
+	}
+	defer c.longConnServer.doneRequest()
+	if disconnect, err := c.longConnServer.CheckRateLimit(ctx, c, binaryReq.ReqIdentifier); err != nil {
+		_ = c.replyMessage(ctx, &binaryReq, err, nil)
+		if disconnect {
+			return ErrRateLimitKicked
+		}
+		return nil
+	}
 	var messageErr error
 	var resp []byte
 	switch binaryReq.ReqIdentifier {
@@ -186,8 +244,18 @@ func (c *Client) handleMessage(message []byte) error {
 		resp, messageErr = c.longConnServer.SendMessage(ctx, binaryReq)
 	case WSSendSignalMsg:
 		resp, messageErr = c.longConnServer.SendSignalMessage(ctx, binaryReq)
+	case WSGetSignalInvitation:
+		resp, messageErr = c.longConnServer.GetSignalInvitations(ctx, binaryReq)
+	case WSSubUserPresence:
+		resp, messageErr = c.longConnServer.SubscribeUsersPresence(ctx, c, binaryReq)
+	case WSSendEphemeral:
+		resp, messageErr = c.longConnServer.SendEphemeral(ctx, binaryReq)
+	case WSResumeSession:
+		resp, messageErr = c.longConnServer.ResumeSession(ctx, c, binaryReq)
 	case WSPullMsgBySeqList:
 		resp, messageErr = c.longConnServer.PullMessageBySeqList(ctx, binaryReq)
+	case WSDeliveryAck:
+		resp, messageErr = c.longConnServer.DeliveryAck(ctx, binaryReq)
 	case WsLogoutMsg:
 		resp, messageErr = c.longConnServer.UserLogout(ctx, binaryReq)
 	case WsSetBackgroundStatus:
@@ -200,7 +268,9 @@ func (c *Client) handleMessage(message []byte) error {
 			binaryReq.ReqIdentifier,
 		)
 	}
-	c.replyMessage(ctx, &binaryReq, messageErr, resp)
+	if err := c.replyMessage(ctx, &binaryReq, messageErr, resp); err == nil && messageErr == nil && !c.isClosed() {
+		c.session.ackReply(binaryReq.ReqIdentifier, resp)
+	}
 	return nil
 }
 
@@ -222,7 +292,14 @@ func (c *Client) close() {
 	c.longConnServer.UnRegister(c)
 }
 
-func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, resp []byte) {
+// closeConn 只关闭底层连接, readMessage退出时再走close完成下线.
+func (c *Client) closeConn() {
+	c.w.Lock()
+	defer c.w.Unlock()
+	_ = c.conn.Close()
+}
+
+func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, resp []byte) error {
 	errResp := apiresp.ParseError(err)
 	mReply := Resp{
 		ReqIdentifier: binaryReq.ReqIdentifier,
@@ -237,6 +314,7 @@ func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, re
 	if err != nil {
 		log.ZWarn(ctx, "wireBinaryMsg replyMessage", err, "resp", mReply.String())
 	}
+	return err
 }
 
 func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error {
@@ -258,6 +336,58 @@ func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error
 		OperationID:   mcontext.GetOperationID(ctx),
 		Data:          data,
 	}
+	if err := c.writeBinaryMsg(resp); err != nil {
+		return err
+	}
+	// 连接已关闭时writeBinaryMsg不返回错误, 但消息没有发出
+	if !c.isClosed() {
+		c.session.ack(conversationID, msgData.Seq)
+	}
+	return nil
+}
+
+func (c *Client) isClosed() bool {
+	c.w.Lock()
+	defer c.w.Unlock()
+	return c.closed
+}
+
+func (c *Client) PushPresence(ctx context.Context, presence *sdkws.UserPresence) error {
+	data, err := proto.Marshal(presence)
+	if err != nil {
+		return err
+	}
+	resp := Resp{
+		ReqIdentifier: WSPushUserPresence,
+		OperationID:   mcontext.GetOperationID(ctx),
+		Data:          data,
+	}
+	return c.writeBinaryMsg(resp)
+}
+
+func (c *Client) PushEphemeral(ctx context.Context, event *sdkws.EphemeralEvent) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	resp := Resp{
+		ReqIdentifier: WSPushEphemeral,
+		OperationID:   mcontext.GetOperationID(ctx),
+		Data:          data,
+	}
+	return c.writeBinaryMsg(resp)
+}
+
+// ReconnectMessage 通知客户端本网关即将下线, 等待backoff后重连到其他网关.
+func (c *Client) ReconnectMessage(backoff time.Duration) error {
+	data, err := proto.Marshal(&sdkws.ReconnectTips{Backoff: backoff.Milliseconds()})
+	if err != nil {
+		return err
+	}
+	resp := Resp{
+		ReqIdentifier: WSReconnect,
+		Data:          data,
+	}
 	return c.writeBinaryMsg(resp)
 }
 
@@ -276,7 +406,7 @@ func (c *Client) writeBinaryMsg(resp Resp) error {
 	}
 	encodedBuf := bufferPool.Get().([]byte)
 	resultBuf := bufferPool.Get().([]byte)
-	encodedBuf, err := c.longConnServer.Encode(resp)
+	encodedBuf, err := c.encoder.Encode(resp)
 	if err != nil {
 		return utils.Wrap(err, "")
 	}
@@ -288,6 +418,9 @@ func (c *Client) writeBinaryMsg(resp Resp) error {
 			return utils.Wrap(compressErr, "")
 		}
 		return c.conn.WriteMessage(MessageBinary, resultBuf)
+	} else if c.Encoding == JsonEncodingProtocol {
+		// 未压缩的json以文本帧发送, 与客户端发来的帧类型一致
+		return c.conn.WriteMessage(MessageText, encodedBuf)
 	} else {
 		return c.conn.WriteMessage(MessageBinary, encodedBuf)
 	}
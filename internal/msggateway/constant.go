@@ -25,11 +25,28 @@ const (
 	OperationID             = "operationID"
 	Compression             = "compression"
 	GzipCompressionProtocol = "gzip"
+	Encoding                = "encoding"
 	BackgroundStatus        = "isBackground"
+	Transport               = "transport"
+	PollAck                 = "ack"
+)
+
+const (
+	WebSocketTransport   = "websocket"
+	LongPollingTransport = "longpolling"
+	SSETransport         = "sse"
+)
+
+const (
+	GobEncodingProtocol      = "gob"
+	ProtobufEncodingProtocol = "protobuf"
+	JsonEncodingProtocol     = "json"
 )
 
 const (
 	WebSocket = iota + 1
+	LongPolling
+	ServerSentEvents
 )
 
 const (
@@ -38,10 +55,18 @@ const (
 	WSPullMsgBySeqList    = 1002
 	WSSendMsg             = 1003
 	WSSendSignalMsg       = 1004
+	WSDeliveryAck         = 1005
+	WSGetSignalInvitation = 1006
+	WSSubUserPresence     = 1007
+	WSSendEphemeral       = 1008
+	WSResumeSession       = 1009
 	WSPushMsg             = 2001
 	WSKickOnlineMsg       = 2002
 	WsLogoutMsg           = 2003
 	WsSetBackgroundStatus = 2004
+	WSPushUserPresence    = 2005
+	WSPushEphemeral       = 2006
+	WSReconnect           = 2007
 	WSDataError           = 3001
 )
 
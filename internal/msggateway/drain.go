@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/shutdown"
+)
+
+const (
+	drainCheckInterval = 100 * time.Millisecond
+	// 强制关闭剩余连接后等待下线处理(保存会话、更新路由)的时间
+	drainUnregisterWait = 3 * time.Second
+)
+
+// startRequest 在处理请求前调用, 网关关闭中返回false.
+// 先计数再检查标记, 保证drain看到计数为0时不会再有新请求开始处理.
+func (ws *WsServer) startRequest() bool {
+	atomic.AddInt64(&ws.inflight, 1)
+	if atomic.LoadInt32(&ws.closing) == 1 {
+		atomic.AddInt64(&ws.inflight, -1)
+		return false
+	}
+	return true
+}
+
+func (ws *WsServer) doneRequest() {
+	atomic.AddInt64(&ws.inflight, -1)
+}
+
+// drain 网关下线流程: 停止接受新连接, 通知客户端随机退避后重连到其他网关,
+// 等待客户端断开, 超时后等待进行中的请求回复完成再关闭剩余连接.
+// 长轮询客户端需要继续轮询才能收到通知, 所以关闭完所有连接后才关闭监听.
+func (ws *WsServer) drain() {
+	ctx := mcontext.NewCtx("drain_" + ws.gatewayAddr)
+	timeout := shutdown.DrainTimeout()
+	deadline := time.Now().Add(timeout)
+	atomic.StoreInt32(&ws.draining, 1)
+	defer ws.shutdownServer(ctx)
+	clients := ws.clients.GetAllClients()
+	log.ZInfo(ctx, "gateway draining", "connNum", len(clients), "timeout", timeout)
+	for _, client := range clients {
+		if err := client.ReconnectMessage(ws.jitterBackoff()); err != nil {
+			log.ZDebug(ctx, "send reconnect message failed", "userID", client.UserID, "err", err)
+		}
+	}
+	// 预留四分之一的时间给进行中的请求
+	waitUntil(time.Now().Add(timeout*3/4), func() bool {
+		return atomic.LoadInt64(&ws.onlineUserConnNum) <= 0
+	})
+	atomic.StoreInt32(&ws.closing, 1)
+	if !waitUntil(deadline, func() bool { return atomic.LoadInt64(&ws.inflight) <= 0 }) {
+		log.ZWarn(ctx, "in-flight requests not finished before drain timeout", nil, "inflight", atomic.LoadInt64(&ws.inflight))
+	}
+	clients = ws.clients.GetAllClients()
+	if len(clients) > 0 {
+		log.ZInfo(ctx, "close remaining conns", "connNum", len(clients))
+		for _, client := range clients {
+			client.closeConn()
+		}
+		waitUntil(time.Now().Add(drainUnregisterWait), func() bool {
+			return atomic.LoadInt64(&ws.onlineUserConnNum) <= 0
+		})
+	}
+	// 等待长轮询客户端取走关闭前的消息
+	waitUntil(time.Now().Add(httpConnCloseGrace), ws.noHttpConns)
+	log.ZInfo(ctx, "gateway drained", "onlineUserConnNum", atomic.LoadInt64(&ws.onlineUserConnNum))
+}
+
+func (ws *WsServer) noHttpConns() bool {
+	empty := true
+	ws.httpConns.Range(func(_, _ interface{}) bool {
+		empty = false
+		return false
+	})
+	return empty
+}
+
+// shutdownServer 连接都已关闭, 挂起的长轮询和sse请求会立即返回, 超时后强制关闭.
+func (ws *WsServer) shutdownServer(ctx context.Context) {
+	if ws.server == nil {
+		return
+	}
+	shutdownCtx, cancel := context.WithTimeout(ctx, drainUnregisterWait)
+	defer cancel()
+	if err := ws.server.Shutdown(shutdownCtx); err != nil {
+		log.ZWarn(ctx, "shutdown websocket listener failed", err)
+		_ = ws.server.Close()
+	}
+}
+
+// jitterBackoff 在[0, reconnectBackoff)内随机, 避免客户端同时涌向其他网关.
+func (ws *WsServer) jitterBackoff() time.Duration {
+	if ws.reconnectBackoff <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ws.reconnectBackoff)))
+}
+
+// waitUntil 轮询直到cond为true或超过deadline, 返回cond最终是否满足.
+func waitUntil(deadline time.Time, cond func() bool) bool {
+	for !cond() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(drainCheckInterval)
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStartRequestAfterClosing(t *testing.T) {
+	ws := &WsServer{}
+	if !ws.startRequest() {
+		t.Fatal("request rejected before closing")
+	}
+	atomic.StoreInt32(&ws.closing, 1)
+	if ws.startRequest() {
+		t.Error("request accepted after closing")
+	}
+	if n := atomic.LoadInt64(&ws.inflight); n != 1 {
+		t.Errorf("inflight = %d, want 1", n)
+	}
+	ws.doneRequest()
+	if !waitUntil(time.Now().Add(time.Second), func() bool { return atomic.LoadInt64(&ws.inflight) == 0 }) {
+		t.Error("inflight not drained")
+	}
+}
+
+func TestJitterBackoff(t *testing.T) {
+	ws := &WsServer{reconnectBackoff: time.Second}
+	for i := 0; i < 100; i++ {
+		if d := ws.jitterBackoff(); d < 0 || d >= time.Second {
+			t.Fatalf("backoff %v out of range", d)
+		}
+	}
+	ws.reconnectBackoff = 0
+	if d := ws.jitterBackoff(); d != 0 {
+		t.Errorf("backoff = %v, want 0", d)
+	}
+}
@@ -17,10 +17,17 @@ package msggateway
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
+	"errors"
 
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
 )
 
+var ErrNotSupportEncodeType = errors.New("not support encode type")
+
 type Encoder interface {
 	Encode(data interface{}) ([]byte, error)
 	Decode(encodeData []byte, decodeData interface{}) error
@@ -51,3 +58,124 @@ func (g *GobEncoder) Decode(encodeData []byte, decodeData interface{}) error {
 	}
 	return nil
 }
+
+// NewEncoder returns the encoder for the protocol negotiated at handshake, gob is used when it is empty.
+func NewEncoder(protocol string) (Encoder, error) {
+	switch protocol {
+	case "", GobEncodingProtocol:
+		return NewGobEncoder(), nil
+	case ProtobufEncodingProtocol:
+		return NewProtobufEncoder(), nil
+	case JsonEncodingProtocol:
+		return NewJsonEncoder(), nil
+	default:
+		return nil, utils.Wrap(ErrNotSupportEncodeType, protocol)
+	}
+}
+
+type ProtobufEncoder struct{}
+
+func NewProtobufEncoder() *ProtobufEncoder {
+	return &ProtobufEncoder{}
+}
+
+func (p *ProtobufEncoder) Encode(data interface{}) ([]byte, error) {
+	var m proto.Message
+	switch v := data.(type) {
+	case Resp:
+		m = respToPb(&v)
+	case *Resp:
+		m = respToPb(v)
+	case Req:
+		m = reqToPb(&v)
+	case *Req:
+		m = reqToPb(v)
+	case proto.Message:
+		m = v
+	default:
+		return nil, utils.Wrap(ErrNotSupportEncodeType, "")
+	}
+	return proto.Marshal(m)
+}
+
+func (p *ProtobufEncoder) Decode(encodeData []byte, decodeData interface{}) error {
+	switch v := decodeData.(type) {
+	case *Req:
+		var pb sdkws.GatewayReq
+		if err := proto.Unmarshal(encodeData, &pb); err != nil {
+			return utils.Wrap(err, "")
+		}
+		*v = Req{
+			ReqIdentifier: pb.ReqIdentifier,
+			Token:         pb.Token,
+			SendID:        pb.SendID,
+			OperationID:   pb.OperationID,
+			MsgIncr:       pb.MsgIncr,
+			Data:          pb.Data,
+		}
+	case *Resp:
+		var pb sdkws.GatewayResp
+		if err := proto.Unmarshal(encodeData, &pb); err != nil {
+			return utils.Wrap(err, "")
+		}
+		*v = Resp{
+			ReqIdentifier: pb.ReqIdentifier,
+			MsgIncr:       pb.MsgIncr,
+			OperationID:   pb.OperationID,
+			ErrCode:       int(pb.ErrCode),
+			ErrMsg:        pb.ErrMsg,
+			Data:          pb.Data,
+		}
+	case proto.Message:
+		if err := proto.Unmarshal(encodeData, v); err != nil {
+			return utils.Wrap(err, "")
+		}
+	default:
+		return utils.Wrap(ErrNotSupportEncodeType, "")
+	}
+	return nil
+}
+
+func reqToPb(r *Req) *sdkws.GatewayReq {
+	return &sdkws.GatewayReq{
+		ReqIdentifier: r.ReqIdentifier,
+		Token:         r.Token,
+		SendID:        r.SendID,
+		OperationID:   r.OperationID,
+		MsgIncr:       r.MsgIncr,
+		Data:          r.Data,
+	}
+}
+
+func respToPb(r *Resp) *sdkws.GatewayResp {
+	return &sdkws.GatewayResp{
+		ReqIdentifier: r.ReqIdentifier,
+		MsgIncr:       r.MsgIncr,
+		OperationID:   r.OperationID,
+		ErrCode:       int32(r.ErrCode),
+		ErrMsg:        r.ErrMsg,
+		Data:          r.Data,
+	}
+}
+
+// JsonEncoder encodes Req/Resp with their json tags, Data is carried as a base64 string.
+type JsonEncoder struct{}
+
+func NewJsonEncoder() *JsonEncoder {
+	return &JsonEncoder{}
+}
+
+func (j *JsonEncoder) Encode(data interface{}) ([]byte, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return b, nil
+}
+
+func (j *JsonEncoder) Decode(encodeData []byte, decodeData interface{}) error {
+	if err := json.Unmarshal(encodeData, decodeData); err != nil {
+		return utils.Wrap(err, "")
+	}
+	return nil
+}
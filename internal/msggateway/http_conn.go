@@ -0,0 +1,452 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const (
+	// 长轮询(GET)和上行消息(POST)的请求路径
+	httpConnPath = "/conn"
+	// 长轮询请求没有下行消息时最长挂起时间, 需小于pongWait
+	pollTimeout = 25 * time.Second
+	// 一次长轮询最多返回的消息数
+	pollMaxMessages = 100
+	// sse心跳间隔, 心跳写成功视为连接存活
+	sseHeartbeatPeriod = 10 * time.Second
+	httpConnQueueSize  = 256
+	// 长轮询连接关闭后保留的时间, 客户端可以取走关闭前写入队列的消息(如踢下线、重连通知)
+	httpConnCloseGrace = 5 * time.Second
+)
+
+var (
+	ErrReadTimeout          = errors.New("read timeout")
+	ErrWriteTimeout         = errors.New("write timeout")
+	ErrDialNotSupported     = errors.New("dial not supported by http transport")
+	ErrStreamingUnsupported = errors.New("streaming unsupported")
+)
+
+type httpFrame struct {
+	messageType int
+	data        []byte
+}
+
+// pollResp Index为Messages中最后一条消息的序号, 客户端在下一次轮询时通过ack参数带回.
+type pollResp struct {
+	ConnID   string   `json:"connID"`
+	Messages [][]byte `json:"messages"`
+	Index    int64    `json:"index"`
+}
+
+// httpConn 基于http的长连接, 供无法使用websocket的客户端.
+// 下行消息进入队列, 由长轮询请求或sse流取出; 上行消息由POST请求写入, 连接用connID+token标识.
+type httpConn struct {
+	protocolType int
+	connID       string
+	token        string
+	in           chan httpFrame
+	out          chan httpFrame
+	closed       chan struct{}
+	closeOnce    sync.Once
+	releaseOnce  sync.Once
+	onClose      func()
+	readTimeout  int64 // time.Duration
+	readDeadline int64 // unix nano
+	writeTimeout int64 // unix nano
+	readLimit    int64
+	isNil        bool
+	pollLock     sync.Mutex
+	// pending 已从队列取出但客户端还未确认的消息, pending[0]的序号为pendingStart
+	pending      [][]byte
+	pendingStart int64
+}
+
+func newHttpConn(protocolType int, connID, token string, onClose func()) *httpConn {
+	return &httpConn{
+		protocolType: protocolType,
+		connID:       connID,
+		token:        token,
+		in:           make(chan httpFrame, httpConnQueueSize),
+		out:          make(chan httpFrame, httpConnQueueSize),
+		closed:       make(chan struct{}),
+		onClose:      onClose,
+		readLimit:    maxMessageSize,
+		pendingStart: 1,
+	}
+}
+
+// Close 长轮询连接队列中还有消息时, 保留到被轮询取走或超过httpConnCloseGrace再释放.
+func (c *httpConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		if c.protocolType == LongPolling && (len(c.out) > 0 || c.hasPending()) {
+			time.AfterFunc(httpConnCloseGrace, c.release)
+			return
+		}
+		c.release()
+	})
+	return nil
+}
+
+func (c *httpConn) release() {
+	c.releaseOnce.Do(func() {
+		if c.onClose != nil {
+			c.onClose()
+		}
+	})
+}
+
+func (c *httpConn) WriteMessage(messageType int, message []byte) error {
+	frame := httpFrame{messageType: messageType, data: append([]byte(nil), message...)}
+	select {
+	case c.out <- frame:
+		return nil
+	case <-c.closed:
+		return ErrConnClosed
+	default:
+	}
+	timer := time.NewTimer(time.Until(time.Unix(0, atomic.LoadInt64(&c.writeTimeout))))
+	defer timer.Stop()
+	select {
+	case c.out <- frame:
+		return nil
+	case <-c.closed:
+		return ErrConnClosed
+	case <-timer.C:
+		return ErrWriteTimeout
+	}
+}
+
+func (c *httpConn) ReadMessage() (int, []byte, error) {
+	for {
+		wait := time.Until(time.Unix(0, atomic.LoadInt64(&c.readDeadline)))
+		if wait <= 0 {
+			return 0, nil, ErrReadTimeout
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case frame := <-c.in:
+			timer.Stop()
+			return frame.messageType, frame.data, nil
+		case <-c.closed:
+			timer.Stop()
+			return 0, nil, ErrConnClosed
+		case <-timer.C:
+			// 期间可能有轮询或心跳延长了deadline, 重新检查
+		}
+	}
+}
+
+func (c *httpConn) SetReadDeadline(timeout time.Duration) error {
+	atomic.StoreInt64(&c.readTimeout, int64(timeout))
+	atomic.StoreInt64(&c.readDeadline, time.Now().Add(timeout).UnixNano())
+	return nil
+}
+
+func (c *httpConn) SetWriteDeadline(timeout time.Duration) error {
+	atomic.StoreInt64(&c.writeTimeout, time.Now().Add(timeout).UnixNano())
+	return nil
+}
+
+// touch 客户端有活动(轮询、上行、sse心跳)时延长读超时.
+func (c *httpConn) touch() {
+	if timeout := atomic.LoadInt64(&c.readTimeout); timeout > 0 {
+		atomic.StoreInt64(&c.readDeadline, time.Now().Add(time.Duration(timeout)).UnixNano())
+	}
+}
+
+func (c *httpConn) Dial(urlStr string, requestHeader http.Header) (*http.Response, error) {
+	return nil, ErrDialNotSupported
+}
+
+func (c *httpConn) IsNil() bool {
+	return c.isNil
+}
+
+func (c *httpConn) SetConnNil() {
+	c.isNil = true
+}
+
+func (c *httpConn) SetReadLimit(limit int64) {
+	atomic.StoreInt64(&c.readLimit, limit)
+}
+
+func (c *httpConn) SetPongHandler(handler PongHandler) {}
+
+// GenerateLongConn 只检查请求能否承载当前传输方式, 应答在连接登记后由serve写出.
+func (c *httpConn) GenerateLongConn(w http.ResponseWriter, r *http.Request) error {
+	if c.protocolType == ServerSentEvents {
+		if _, ok := w.(http.Flusher); !ok {
+			return ErrStreamingUnsupported
+		}
+	}
+	setCorsHeader(w)
+	return nil
+}
+
+// serve 处理建立连接的请求: 长轮询返回connID, sse保持请求持续推送下行消息直到连接关闭.
+func (c *httpConn) serve(w http.ResponseWriter, r *http.Request) {
+	if c.protocolType == LongPolling {
+		c.writePollResp(w, nil, 0)
+		return
+	}
+	flusher := w.(http.Flusher)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	open, _ := json.Marshal(pollResp{ConnID: c.connID})
+	if _, err := io.WriteString(w, "event: open\ndata: "+string(open)+"\n\n"); err != nil {
+		c.Close()
+		return
+	}
+	flusher.Flush()
+	heartbeat := time.NewTicker(sseHeartbeatPeriod)
+	defer heartbeat.Stop()
+	for {
+		var err error
+		select {
+		case frame := <-c.out:
+			err = c.writeEvent(w, frame)
+		case <-heartbeat.C:
+			if _, err = io.WriteString(w, ": ping\n\n"); err == nil {
+				c.touch()
+			}
+		case <-r.Context().Done():
+			c.Close()
+			return
+		case <-c.closed:
+			// 关闭前写入队列的消息(如踢下线)尽量发出
+			for {
+				select {
+				case frame := <-c.out:
+					if c.writeEvent(w, frame) != nil {
+						return
+					}
+				default:
+					flusher.Flush()
+					return
+				}
+			}
+		}
+		if err != nil {
+			c.Close()
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func (c *httpConn) writeEvent(w io.Writer, frame httpFrame) error {
+	_, err := io.WriteString(w, "data: "+base64.StdEncoding.EncodeToString(frame.data)+"\n\n")
+	return err
+}
+
+// poll 挂起直到有下行消息、超时或连接关闭, 一次返回队列中的多条消息.
+// 携带ack参数的轮询确认序号不大于ack的消息, 未确认的消息在下一次轮询时重新返回, 避免应答未送达时丢失;
+// 不带ack参数的客户端在消息写入应答后即视为已确认.
+func (c *httpConn) poll(w http.ResponseWriter, r *http.Request) {
+	c.touch()
+	defer c.touch()
+	query := r.URL.Query()
+	retain := query.Has(PollAck)
+	if retain {
+		ack, err := strconv.ParseInt(query.Get(PollAck), 10, 64)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		c.pollLock.Lock()
+		c.ackPending(ack)
+		c.pollLock.Unlock()
+	}
+	if !c.hasPending() {
+		timer := time.NewTimer(pollTimeout)
+		defer timer.Stop()
+		select {
+		case frame := <-c.out:
+			c.pollLock.Lock()
+			c.pending = append(c.pending, frame.data)
+			c.pollLock.Unlock()
+		case <-timer.C:
+		case <-r.Context().Done():
+			return
+		case <-c.closed:
+		}
+	}
+	c.pollLock.Lock()
+loop:
+	for len(c.pending) < pollMaxMessages {
+		select {
+		case frame := <-c.out:
+			c.pending = append(c.pending, frame.data)
+		default:
+			break loop
+		}
+	}
+	n := utils.Min(len(c.pending), pollMaxMessages)
+	messages := append([][]byte(nil), c.pending[:n]...)
+	index := c.pendingStart + int64(n) - 1
+	if !retain {
+		c.ackPending(index)
+	}
+	drained := len(c.pending) == 0
+	c.pollLock.Unlock()
+	if c.isClosed() {
+		if len(messages) == 0 {
+			c.release()
+			http.Error(w, ErrConnClosed.Error(), http.StatusGone)
+			return
+		}
+		// 需要确认的消息保留到客户端确认或超过httpConnCloseGrace
+		if drained && len(c.out) == 0 {
+			c.release()
+		}
+	}
+	c.writePollResp(w, messages, index)
+}
+
+// ackPending 删除序号不大于ack的消息, 需持有pollLock.
+func (c *httpConn) ackPending(ack int64) {
+	n := ack - c.pendingStart + 1
+	if n <= 0 {
+		return
+	}
+	if n > int64(len(c.pending)) {
+		n = int64(len(c.pending))
+	}
+	c.pending = c.pending[n:]
+	c.pendingStart += n
+}
+
+func (c *httpConn) hasPending() bool {
+	c.pollLock.Lock()
+	defer c.pollLock.Unlock()
+	return len(c.pending) > 0
+}
+
+func (c *httpConn) writePollResp(w http.ResponseWriter, messages [][]byte, index int64) {
+	data, err := json.Marshal(pollResp{ConnID: c.connID, Messages: messages, Index: index})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// send 上行消息, json/text请求体按文本帧处理, 其他按二进制帧处理.
+func (c *httpConn) send(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, atomic.LoadInt64(&c.readLimit)))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	messageType := MessageBinary
+	if contentType := r.Header.Get("Content-Type"); strings.HasPrefix(contentType, "application/json") ||
+		strings.HasPrefix(contentType, "text/") {
+		messageType = MessageText
+	}
+	c.touch()
+	select {
+	case c.in <- httpFrame{messageType: messageType, data: body}:
+		w.WriteHeader(http.StatusNoContent)
+	case <-c.closed:
+		http.Error(w, ErrConnClosed.Error(), http.StatusGone)
+	case <-r.Context().Done():
+	}
+}
+
+func (c *httpConn) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *httpConn) checkToken(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(c.token), []byte(token)) == 1
+}
+
+// setCorsHeader http传输的后续请求可能跨域, 与websocket的CheckOrigin一样不限制来源.
+func setCorsHeader(w http.ResponseWriter) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, "+Compression+", "+Encoding)
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+}
+
+// httpConnHandler 处理http传输建立连接之后的请求: GET为长轮询, POST为上行消息, 通过connID和token找到连接.
+func (ws *WsServer) httpConnHandler(w http.ResponseWriter, r *http.Request) {
+	setCorsHeader(w)
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	connContext := newContext(w, r)
+	connID, _ := connContext.Query(ConnID)
+	token, _ := connContext.Query(Token)
+	v, ok := ws.httpConns.Load(connID)
+	if !ok || !v.(*httpConn).checkToken(token) {
+		// 客户端收到410后需要重新建立连接
+		http.Error(w, ErrConnClosed.Error(), http.StatusGone)
+		return
+	}
+	conn := v.(*httpConn)
+	switch r.Method {
+	case http.MethodGet:
+		if conn.protocolType != LongPolling {
+			http.Error(w, ErrNotSupportMessageProtocol.Error(), http.StatusBadRequest)
+			return
+		}
+		conn.poll(w, r)
+	case http.MethodPost:
+		conn.send(w, r)
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// newLongConn 按连接时协商的transport创建连接, 默认websocket.
+func (ws *WsServer) newLongConn(connContext *UserConnContext, token string) (LongConn, error) {
+	transport, _ := connContext.Query(Transport)
+	switch transport {
+	case "", WebSocketTransport:
+		return newGWebSocket(WebSocket, ws.handshakeTimeout), nil
+	case LongPollingTransport, SSETransport:
+		protocolType := LongPolling
+		if transport == SSETransport {
+			protocolType = ServerSentEvents
+		}
+		connID := connContext.GetConnID()
+		return newHttpConn(protocolType, connID, token, func() { ws.httpConns.Delete(connID) }), nil
+	default:
+		return nil, errs.ErrConnArgsErr.Wrap("unknown transport " + transport)
+	}
+}
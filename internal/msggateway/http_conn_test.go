@@ -0,0 +1,133 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHttpConnPollAndSend(t *testing.T) {
+	conn := newHttpConn(LongPolling, "conn", "token", nil)
+	_ = conn.SetReadDeadline(time.Second)
+	_ = conn.SetWriteDeadline(time.Second)
+	if err := conn.WriteMessage(MessageBinary, []byte("down")); err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	conn.poll(w, httptest.NewRequest(http.MethodGet, httpConnPath, nil))
+	var resp pollResp
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Messages) != 1 || string(resp.Messages[0]) != "down" {
+		t.Errorf("poll messages = %q", resp.Messages)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, httpConnPath, bytes.NewReader([]byte(`{"reqIdentifier":1001}`)))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	conn.send(w, req)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("send status = %d", w.Code)
+	}
+	messageType, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if messageType != MessageText || string(data) != `{"reqIdentifier":1001}` {
+		t.Errorf("read %d %s", messageType, data)
+	}
+}
+
+func TestHttpConnClose(t *testing.T) {
+	var removed bool
+	conn := newHttpConn(LongPolling, "conn", "token", func() { removed = true })
+	_ = conn.SetReadDeadline(time.Second)
+	_ = conn.Close()
+	_ = conn.Close()
+	if !removed {
+		t.Error("onClose not called")
+	}
+	if _, _, err := conn.ReadMessage(); err != ErrConnClosed {
+		t.Errorf("read after close: %v", err)
+	}
+	w := httptest.NewRecorder()
+	conn.poll(w, httptest.NewRequest(http.MethodGet, httpConnPath, nil))
+	if w.Code != http.StatusGone {
+		t.Errorf("poll after close status = %d", w.Code)
+	}
+	if conn.checkToken("other") {
+		t.Error("wrong token accepted")
+	}
+}
+
+// 关闭前写入的消息(如踢下线)仍能被下一次轮询取走, 取走后才释放连接.
+func TestHttpConnPollAfterClose(t *testing.T) {
+	var removed bool
+	conn := newHttpConn(LongPolling, "conn", "token", func() { removed = true })
+	_ = conn.SetWriteDeadline(time.Second)
+	if err := conn.WriteMessage(MessageBinary, []byte("kick")); err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.Close()
+	if removed {
+		t.Fatal("conn released before queued messages polled")
+	}
+	w := httptest.NewRecorder()
+	conn.poll(w, httptest.NewRequest(http.MethodGet, httpConnPath, nil))
+	var resp pollResp
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Messages) != 1 || string(resp.Messages[0]) != "kick" {
+		t.Errorf("poll messages = %q", resp.Messages)
+	}
+	if !removed {
+		t.Error("conn not released after queue drained")
+	}
+}
+
+// 携带ack的轮询未确认的消息在下一次轮询时重新返回.
+func TestHttpConnPollAck(t *testing.T) {
+	conn := newHttpConn(LongPolling, "conn", "token", nil)
+	_ = conn.SetWriteDeadline(time.Second)
+	poll := func(ack string) pollResp {
+		w := httptest.NewRecorder()
+		conn.poll(w, httptest.NewRequest(http.MethodGet, httpConnPath+"?ack="+ack, nil))
+		var resp pollResp
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	_ = conn.WriteMessage(MessageBinary, []byte("a"))
+	if resp := poll("0"); len(resp.Messages) != 1 || string(resp.Messages[0]) != "a" || resp.Index != 1 {
+		t.Fatalf("first poll %q index %d", resp.Messages, resp.Index)
+	}
+	_ = conn.WriteMessage(MessageBinary, []byte("b"))
+	// 上一次应答未送达, 客户端仍带ack=0
+	if resp := poll("0"); len(resp.Messages) != 2 || string(resp.Messages[0]) != "a" || resp.Index != 2 {
+		t.Fatalf("retry poll %q index %d", resp.Messages, resp.Index)
+	}
+	_ = conn.WriteMessage(MessageBinary, []byte("c"))
+	if resp := poll("2"); len(resp.Messages) != 1 || string(resp.Messages[0]) != "c" || resp.Index != 3 {
+		t.Fatalf("acked poll %q index %d", resp.Messages, resp.Index)
+	}
+}
@@ -16,6 +16,8 @@ package msggateway
 
 import (
 	"context"
+	"net"
+	"strconv"
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
 
@@ -24,9 +26,12 @@ import (
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/network"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/prome"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/discoveryregistry"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msggateway"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/startrpc"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
 )
@@ -37,8 +42,14 @@ func (s *Server) InitServer(client discoveryregistry.SvcDiscoveryRegistry, serve
 		return err
 	}
 	msgModel := cache.NewMsgCacheModel(rdb)
+	registerIP, err := network.GetRpcRegisterIP(config.Config.Rpc.RegisterIP)
+	if err != nil {
+		return err
+	}
 	s.LongConnServer.SetDiscoveryRegistry(client)
 	s.LongConnServer.SetCacheHandler(msgModel)
+	// 与注册到服务发现的地址一致, 推送端按该地址找到网关连接
+	s.LongConnServer.SetGatewayAddr(net.JoinHostPort(registerIP, strconv.Itoa(s.rpcPort)))
 	msggateway.RegisterMsgGatewayServer(server, s)
 	return nil
 }
@@ -72,10 +83,14 @@ func NewServer(rpcPort int, longConnServer LongConnServer) *Server {
 }
 
 func (s *Server) OnlinePushMsg(
-	context context.Context,
+	ctx context.Context,
 	req *msggateway.OnlinePushMsgReq,
 ) (*msggateway.OnlinePushMsgResp, error) {
-	panic("implement me")
+	if req.MsgData == nil || req.PushToUserID == "" {
+		return nil, errs.ErrArgs.Wrap("msgData or pushToUserID is empty")
+	}
+	result := s.pushToUser(ctx, req.PushToUserID, req.MsgData)
+	return &msggateway.OnlinePushMsgResp{Resp: result.Resp}, nil
 }
 
 func (s *Server) GetUsersOnlineStatus(
@@ -113,7 +128,10 @@ func (s *Server) OnlineBatchPushOneMsg(
 	ctx context.Context,
 	req *msggateway.OnlineBatchPushOneMsgReq,
 ) (*msggateway.OnlineBatchPushOneMsgResp, error) {
-	panic("implement me")
+	if req.MsgData == nil {
+		return nil, errs.ErrArgs.Wrap("msgData is empty")
+	}
+	return s.SuperGroupOnlineBatchPushOneMsg(ctx, req)
 }
 
 func (s *Server) SuperGroupOnlineBatchPushOneMsg(
@@ -122,50 +140,78 @@ func (s *Server) SuperGroupOnlineBatchPushOneMsg(
 ) (*msggateway.OnlineBatchPushOneMsgResp, error) {
 	var singleUserResult []*msggateway.SingleMsgToUserResults
 	for _, v := range req.PushToUserIDs {
-		var resp []*msggateway.SingleMsgToUserPlatform
-		tempT := &msggateway.SingleMsgToUserResults{
-			UserID: v,
+		singleUserResult = append(singleUserResult, s.pushToUser(ctx, v, req.MsgData))
+	}
+	return &msggateway.OnlineBatchPushOneMsgResp{
+		SinglePushResult: singleUserResult,
+	}, nil
+}
+
+// pushToUser 推送消息到用户在本网关上的所有连接, 返回各平台的推送结果.
+func (s *Server) pushToUser(ctx context.Context, userID string, msgData *sdkws.MsgData) *msggateway.SingleMsgToUserResults {
+	var resp []*msggateway.SingleMsgToUserPlatform
+	tempT := &msggateway.SingleMsgToUserResults{
+		UserID: userID,
+	}
+	clients, ok := s.LongConnServer.GetUserAllCons(userID)
+	if !ok {
+		log.ZDebug(ctx, "push user not online", "userID", userID)
+		tempT.Resp = resp
+		return tempT
+	}
+	log.ZDebug(ctx, "push user online", "clients", clients, "userID", userID)
+	for _, client := range clients {
+		if client != nil {
+			temp := &msggateway.SingleMsgToUserPlatform{
+				RecvID:         userID,
+				RecvPlatFormID: int32(client.PlatformID),
+			}
+			if !client.IsBackground ||
+				(client.IsBackground == true && client.PlatformID != constant.IOSPlatformID) {
+				err := client.PushMessage(ctx, msgData)
+				if err != nil {
+					temp.ResultCode = -2
+					resp = append(resp, temp)
+				} else {
+					if utils.IsContainInt(client.PlatformID, s.pushTerminal) {
+						tempT.OnlinePush = true
+						prome.Inc(prome.MsgOnlinePushSuccessCounter)
+						resp = append(resp, temp)
+					}
+				}
+			} else {
+				temp.ResultCode = -3
+				resp = append(resp, temp)
+			}
 		}
-		clients, ok := s.LongConnServer.GetUserAllCons(v)
+	}
+	tempT.Resp = resp
+	return tempT
+}
+
+func (s *Server) OnlinePushEphemeral(
+	ctx context.Context,
+	req *msggateway.OnlinePushEphemeralReq,
+) (*msggateway.OnlinePushEphemeralResp, error) {
+	if req.Event == nil {
+		return nil, errs.ErrArgs.Wrap("event is nil")
+	}
+	for _, userID := range req.PushToUserIDs {
+		clients, ok := s.LongConnServer.GetUserAllCons(userID)
 		if !ok {
-			log.ZDebug(ctx, "push user not online", "userID", v)
-			tempT.Resp = resp
-			singleUserResult = append(singleUserResult, tempT)
 			continue
 		}
-		log.ZDebug(ctx, "push user online", "clients", clients, "userID", v)
 		for _, client := range clients {
-			if client != nil {
-				temp := &msggateway.SingleMsgToUserPlatform{
-					RecvID:         v,
-					RecvPlatFormID: int32(client.PlatformID),
-				}
-				if !client.IsBackground ||
-					(client.IsBackground == true && client.PlatformID != constant.IOSPlatformID) {
-					err := client.PushMessage(ctx, req.MsgData)
-					if err != nil {
-						temp.ResultCode = -2
-						resp = append(resp, temp)
-					} else {
-						if utils.IsContainInt(client.PlatformID, s.pushTerminal) {
-							tempT.OnlinePush = true
-							prome.Inc(prome.MsgOnlinePushSuccessCounter)
-							resp = append(resp, temp)
-						}
-					}
-				} else {
-					temp.ResultCode = -3
-					resp = append(resp, temp)
-				}
+			// 后台的连接不需要临时事件
+			if client == nil || client.IsBackground {
+				continue
+			}
+			if err := client.PushEphemeral(ctx, req.Event); err != nil {
+				log.ZDebug(ctx, "PushEphemeral failed", "err", err, "userID", userID, "platformID", client.PlatformID)
 			}
 		}
-		tempT.Resp = resp
-		singleUserResult = append(singleUserResult, tempT)
 	}
-
-	return &msggateway.OnlineBatchPushOneMsgResp{
-		SinglePushResult: singleUserResult,
-	}, nil
+	return &msggateway.OnlinePushEphemeralResp{}, nil
 }
 
 func (s *Server) KickUserOffline(
@@ -191,6 +237,12 @@ func (s *Server) MultiTerminalLoginCheck(
 	ctx context.Context,
 	req *msggateway.MultiTerminalLoginCheckReq,
 ) (*msggateway.MultiTerminalLoginCheckResp, error) {
-	// TODO implement me
-	panic("implement me")
+	resp := &msggateway.MultiTerminalLoginCheckResp{}
+	for _, client := range s.LongConnServer.KickOldTerminals(ctx, req.UserID, int(req.PlatformID), req.Token) {
+		resp.KickedResp = append(resp.KickedResp, &msggateway.SingleMsgToUserPlatform{
+			RecvID:         req.UserID,
+			RecvPlatFormID: int32(client.PlatformID),
+		})
+	}
+	return resp, nil
 }
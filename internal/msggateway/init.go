@@ -34,12 +34,20 @@ func RunWsAndServer(rpcPort, wsPort, prometheusPort int) error {
 		WithPort(wsPort),
 		WithMaxConnNum(int64(config.Config.LongConnSvr.WebsocketMaxConnNum)),
 		WithHandshakeTimeout(time.Duration(config.Config.LongConnSvr.WebsocketTimeout)*time.Second),
-		WithMessageMaxMsgLength(config.Config.LongConnSvr.WebsocketMaxMsgLen))
+		WithMessageMaxMsgLength(config.Config.LongConnSvr.WebsocketMaxMsgLen),
+		WithResumeWindow(time.Duration(config.Config.LongConnSvr.ResumeWindow)*time.Second),
+		WithReconnectBackoff(time.Duration(config.Config.LongConnSvr.ReconnectBackoff)*time.Second))
 	if err != nil {
 		return err
 	}
 	hubServer := NewServer(rpcPort, longServer)
-	go hubServer.Start()
-	hubServer.LongConnServer.Run()
-	return nil
+	rpcErr := make(chan error, 1)
+	go func() {
+		rpcErr <- hubServer.Start()
+	}()
+	if err := hubServer.LongConnServer.Run(); err != nil {
+		return err
+	}
+	// 等待rpc服务摘除注册并处理完进行中的推送
+	return <-rpcErr
 }
@@ -23,7 +23,10 @@ import (
 	"github.com/go-playground/validator/v10"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/rtc"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/rpcclient"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
@@ -59,7 +62,10 @@ type MessageHandler interface {
 	GetSeq(context context.Context, data Req) ([]byte, error)
 	SendMessage(context context.Context, data Req) ([]byte, error)
 	SendSignalMessage(context context.Context, data Req) ([]byte, error)
+	GetSignalInvitations(context context.Context, data Req) ([]byte, error)
 	PullMessageBySeqList(context context.Context, data Req) ([]byte, error)
+	DeliveryAck(context context.Context, data Req) ([]byte, error)
+	SendEphemeral(context context.Context, data Req) ([]byte, error)
 	UserLogout(context context.Context, data Req) ([]byte, error)
 	SetUserDeviceBackground(context context.Context, data Req) ([]byte, bool, error)
 }
@@ -69,15 +75,18 @@ var _ MessageHandler = (*GrpcHandler)(nil)
 type GrpcHandler struct {
 	msgRpcClient *rpcclient.MessageRpcClient
 	pushClient   *rpcclient.PushRpcClient
+	rtcClient    *rpcclient.RtcRpcClient
 	validate     *validator.Validate
 }
 
 func NewGrpcHandler(validate *validator.Validate, client discoveryregistry.SvcDiscoveryRegistry) *GrpcHandler {
 	msgRpcClient := rpcclient.NewMessageRpcClient(client)
 	pushRpcClient := rpcclient.NewPushRpcClient(client)
+	rtcRpcClient := rpcclient.NewRtcRpcClient(client)
 	return &GrpcHandler{
 		msgRpcClient: &msgRpcClient,
-		pushClient:   &pushRpcClient, validate: validate,
+		pushClient:   &pushRpcClient,
+		rtcClient:    &rtcRpcClient, validate: validate,
 	}
 }
 
@@ -121,7 +130,25 @@ func (g GrpcHandler) SendMessage(context context.Context, data Req) ([]byte, err
 }
 
 func (g GrpcHandler) SendSignalMessage(context context.Context, data Req) ([]byte, error) {
-	resp, err := g.msgRpcClient.SendMsg(context, nil)
+	req := sdkws.SignalReq{}
+	if err := proto.Unmarshal(data.Data, &req); err != nil {
+		return nil, err
+	}
+	resp, err := g.rtcClient.SignalMessageAssemble(context, &req)
+	if err != nil {
+		return nil, err
+	}
+	c, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetSignalInvitations 重连后获取还在等待应答的音视频邀请.
+func (g GrpcHandler) GetSignalInvitations(context context.Context, data Req) ([]byte, error) {
+	req := rtc.SignalGetPendingInvitationsReq{UserID: data.SendID}
+	resp, err := g.rtcClient.SignalGetPendingInvitations(context, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +178,42 @@ func (g GrpcHandler) PullMessageBySeqList(context context.Context, data Req) ([]
 	return c, nil
 }
 
+func (g GrpcHandler) DeliveryAck(context context.Context, data Req) ([]byte, error) {
+	req := msg.MsgDeliveryAckReq{}
+	if err := proto.Unmarshal(data.Data, &req); err != nil {
+		return nil, err
+	}
+	// 只能确认自己收到的消息
+	req.UserID = data.SendID
+	resp, err := g.msgRpcClient.MsgDeliveryAck(context, &req)
+	if err != nil {
+		return nil, err
+	}
+	c, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (g GrpcHandler) SendEphemeral(context context.Context, data Req) ([]byte, error) {
+	event := sdkws.EphemeralEvent{}
+	if err := proto.Unmarshal(data.Data, &event); err != nil {
+		return nil, err
+	}
+	event.SendID = data.SendID
+	event.SenderPlatformID = int32(constant.PlatformNameToID(mcontext.GetOpUserPlatform(context)))
+	resp, err := g.msgRpcClient.SendEphemeral(context, &msg.SendEphemeralReq{Event: &event})
+	if err != nil {
+		return nil, err
+	}
+	c, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
 func (g GrpcHandler) UserLogout(context context.Context, data Req) ([]byte, error) {
 	req := push.DelUserPushTokenReq{}
 	if err := proto.Unmarshal(data.Data, &req); err != nil {
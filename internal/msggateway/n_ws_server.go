@@ -26,6 +26,7 @@ import (
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
 
 	"github.com/redis/go-redis/v9"
 
@@ -34,8 +35,12 @@ import (
 	"github.com/go-playground/validator/v10"
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/shutdown"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msggateway"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/rpcclient"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
 )
 
@@ -47,8 +52,15 @@ type LongConnServer interface {
 	Validate(s interface{}) error
 	SetCacheHandler(cache cache.MsgModel)
 	SetDiscoveryRegistry(client discoveryregistry.SvcDiscoveryRegistry)
+	SetGatewayAddr(addr string)
 	KickUserConn(client *Client) error
+	KickOldTerminals(ctx context.Context, userID string, platformID int, token string) []*Client
 	UnRegister(c *Client)
+	SubscribeUsersPresence(ctx context.Context, client *Client, data Req) ([]byte, error)
+	ResumeSession(ctx context.Context, client *Client, data Req) ([]byte, error)
+	CheckRateLimit(ctx context.Context, client *Client, reqIdentifier int32) (disconnect bool, err error)
+	startRequest() bool
+	doneRequest()
 	Compressor
 	Encoder
 	MessageHandler
@@ -65,31 +77,47 @@ type WsServer struct {
 	wsMaxConnNum      int64
 	registerChan      chan *Client
 	unregisterChan    chan *Client
-	kickHandlerChan   chan *kickHandler
 	clients           *UserMap
 	clientPool        sync.Pool
 	onlineUserNum     int64
 	onlineUserConnNum int64
 	handshakeTimeout  time.Duration
+	resumeWindow      time.Duration
+	reconnectBackoff  time.Duration
+	server            *http.Server
+	draining          int32    // 不再接受新连接
+	closing           int32    // 不再处理新请求
+	inflight          int64    // 进行中的请求数
+	httpConns         sync.Map // connID -> *httpConn, 长轮询和sse连接
 	hubServer         *Server
 	validate          *validator.Validate
 	cache             cache.MsgModel
+	disCov            discoveryregistry.SvcDiscoveryRegistry
+	gatewayAddr       string
+	presenceDatabase  controller.PresenceDatabase
+	presenceSubs      *presenceSubscriber
+	rateLimiters      *rateLimiters
+	routeUpdates      *routeUpdates
+	msgRpcClient      *rpcclient.MessageRpcClient
+	userRpcClient     *rpcclient.UserRpcClient
 	Compressor
 	Encoder
 	MessageHandler
 }
-type kickHandler struct {
-	clientOK   bool
-	oldClients []*Client
-	newClient  *Client
-}
 
 func (ws *WsServer) SetDiscoveryRegistry(client discoveryregistry.SvcDiscoveryRegistry) {
 	ws.MessageHandler = NewGrpcHandler(ws.validate, client)
+	msgRpcClient := rpcclient.NewMessageRpcClient(client)
+	ws.msgRpcClient = &msgRpcClient
+	userRpcClient := rpcclient.NewUserRpcClient(client)
+	ws.userRpcClient = &userRpcClient
+	ws.disCov = client
 }
 
 func (ws *WsServer) SetCacheHandler(cache cache.MsgModel) {
 	ws.cache = cache
+	ws.presenceDatabase = controller.NewPresenceDatabase(cache)
+	go ws.presenceLoop()
 }
 
 func (ws *WsServer) UnRegister(c *Client) {
@@ -117,23 +145,28 @@ func NewWsServer(opts ...Option) (*WsServer, error) {
 		return nil, errors.New("port not allow to listen")
 	}
 	v := validator.New()
-	return &WsServer{
+	ws := &WsServer{
 		port:             config.port,
 		wsMaxConnNum:     config.maxConnNum,
 		handshakeTimeout: config.handshakeTimeout,
+		resumeWindow:     config.resumeWindow,
+		reconnectBackoff: config.reconnectBackoff,
 		clientPool: sync.Pool{
 			New: func() interface{} {
 				return new(Client)
 			},
 		},
-		registerChan:    make(chan *Client, 1000),
-		unregisterChan:  make(chan *Client, 1000),
-		kickHandlerChan: make(chan *kickHandler, 1000),
-		validate:        v,
-		clients:         newUserMap(),
-		Compressor:      NewGzipCompressor(),
-		Encoder:         NewGobEncoder(),
-	}, nil
+		registerChan:   make(chan *Client, 1000),
+		unregisterChan: make(chan *Client, 1000),
+		validate:       v,
+		clients:        newUserMap(),
+		presenceSubs:   newPresenceSubscriber(),
+		routeUpdates:   newRouteUpdates(),
+		Compressor:     NewGzipCompressor(),
+		Encoder:        NewGobEncoder(),
+	}
+	ws.initRateLimitPrometheus()
+	return ws, nil
 }
 
 func (ws *WsServer) Run() error {
@@ -145,17 +178,30 @@ func (ws *WsServer) Run() error {
 				ws.registerClient(client)
 			case client = <-ws.unregisterChan:
 				ws.unregisterClient(client)
-			case onlineInfo := <-ws.kickHandlerChan:
-				ws.multiTerminalLoginChecker(onlineInfo.clientOK, onlineInfo.oldClients, onlineInfo.newClient)
 			}
 		}
 	}()
 	http.HandleFunc("/", ws.wsHandler)
+	http.HandleFunc(httpConnPath, ws.httpConnHandler)
 	// http.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {})
-	return http.ListenAndServe(":"+utils.IntToString(ws.port), nil) // Start listening
+	ws.server = &http.Server{Addr: ":" + utils.IntToString(ws.port)}
+	drained := make(chan struct{})
+	shutdown.Go(func() {
+		defer close(drained)
+		<-shutdown.Context().Done()
+		ws.drain()
+	})
+	if err := ws.server.ListenAndServe(); err != http.ErrServerClosed { // Start listening
+		return err
+	}
+	<-drained
+	return nil
 }
 
 func (ws *WsServer) registerClient(client *Client) {
+	if client.registered != nil {
+		defer close(client.registered)
+	}
 	var (
 		userOK     bool
 		clientOK   bool
@@ -168,12 +214,6 @@ func (ws *WsServer) registerClient(client *Client) {
 		atomic.AddInt64(&ws.onlineUserNum, 1)
 		atomic.AddInt64(&ws.onlineUserConnNum, 1)
 	} else {
-		i := &kickHandler{
-			clientOK:   clientOK,
-			oldClients: oldClients,
-			newClient:  client,
-		}
-		ws.kickHandlerChan <- i
 		log.ZDebug(client.ctx, "user exist", "userID", client.UserID, "platformID", client.PlatformID)
 		if clientOK {
 			ws.clients.Set(client.UserID, client)
@@ -186,6 +226,14 @@ func (ws *WsServer) registerClient(client *Client) {
 			atomic.AddInt64(&ws.onlineUserConnNum, 1)
 		}
 	}
+	client.routeFlushed = ws.publishUserRoute(client.UserID)
+	if !clientOK {
+		ws.publishUserPresence(client.UserID)
+	}
+	if config.Config.MultiLoginPolicy != constant.DefalutNotKick {
+		// 在Run的事件循环中直接处理, 不能再写入由事件循环读取的channel
+		ws.multiTerminalLoginChecker(client)
+	}
 	log.ZInfo(
 		client.ctx,
 		"user online",
@@ -210,87 +258,167 @@ func getRemoteAdders(client []*Client) string {
 
 func (ws *WsServer) KickUserConn(client *Client) error {
 	ws.clients.deleteClients(client.UserID, []*Client{client})
+	ws.publishUserRoute(client.UserID)
+	ws.publishUserPresence(client.UserID)
 	return client.KickOnlineMessage()
 }
 
-func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Client, newClient *Client) {
+func (ws *WsServer) multiTerminalLoginChecker(newClient *Client) {
+	var kickClients []*Client
+	clients, _ := ws.clients.GetAll(newClient.UserID)
+	for _, c := range clients {
+		if c != newClient && needKick(newClient.PlatformID, c.PlatformID) {
+			kickClients = append(kickClients, c)
+		}
+	}
+	ws.kickClients(newClient.ctx, newClient.UserID, kickClients)
+	// 标记旧token和通知其他网关都需要访问redis或rpc, 不阻塞事件循环
+	ctx := mcontext.WithMustInfoCtx(
+		[]string{newClient.ctx.GetOperationID(), newClient.UserID, constant.PlatformIDToName(newClient.PlatformID), newClient.ctx.GetConnID()},
+	)
+	userID, platformID, token := newClient.UserID, newClient.PlatformID, newClient.token
+	go func() {
+		ws.kickTokens(ctx, userID, platformID, token)
+		// 用户的其他连接可能在别的网关上
+		ws.remoteMultiTerminalLoginCheck(ctx, userID, platformID, token)
+	}()
+}
+
+// needKick 新连接登录后, 按多端登录策略判断是否要踢掉已在线的旧连接.
+func needKick(newPlatformID, oldPlatformID int) bool {
+	newClass := constant.PlatformIDToClass(newPlatformID)
+	oldClass := constant.PlatformIDToClass(oldPlatformID)
 	switch config.Config.MultiLoginPolicy {
-	case constant.DefalutNotKick:
+	case constant.AllLoginButSameTermKick:
+		return newPlatformID == oldPlatformID
+	case constant.SingleTerminalLogin:
+		return true
+	case constant.WebAndOther:
+		return newClass != constant.WebPlatformStr && oldClass != constant.WebPlatformStr
+	case constant.PcMobileAndWeb:
+		switch newClass {
+		case constant.WebPlatformStr:
+			return false
+		case constant.TerminalPC, constant.TerminalMobile:
+			return newClass == oldClass
+		default:
+			return newPlatformID == oldPlatformID
+		}
 	case constant.PCAndOther:
-		if constant.PlatformIDToClass(newClient.PlatformID) == constant.TerminalPC {
-			return
+		return newClass != constant.TerminalPC && oldClass != constant.TerminalPC
+	}
+	return false
+}
+
+// KickOldTerminals kicks the local conns of the user which conflict with the new login, the conns of the new token are kept.
+func (ws *WsServer) KickOldTerminals(ctx context.Context, userID string, platformID int, token string) []*Client {
+	if config.Config.MultiLoginPolicy == constant.DefalutNotKick {
+		return nil
+	}
+	var kickClients []*Client
+	clients, _ := ws.clients.GetAll(userID)
+	for _, c := range clients {
+		if c.token != token && needKick(platformID, c.PlatformID) {
+			kickClients = append(kickClients, c)
 		}
-		fallthrough
-	case constant.AllLoginButSameTermKick:
-		if clientOK {
-			ws.clients.deleteClients(newClient.UserID, oldClients)
-			for _, c := range oldClients {
-				err := c.KickOnlineMessage()
-				if err != nil {
-					log.ZWarn(c.ctx, "KickOnlineMessage", err)
-				}
-			}
-			m, err := ws.cache.GetTokensWithoutError(
-				newClient.ctx,
-				newClient.UserID,
-				newClient.PlatformID,
-			)
-			if err != nil && err != redis.Nil {
-				log.ZWarn(
-					newClient.ctx,
-					"get token from redis err",
-					err,
-					"userID",
-					newClient.UserID,
-					"platformID",
-					newClient.PlatformID,
-				)
-				return
-			}
-			if m == nil {
-				log.ZWarn(
-					newClient.ctx,
-					"m is nil",
-					errors.New("m is nil"),
-					"userID",
-					newClient.UserID,
-					"platformID",
-					newClient.PlatformID,
-				)
-				return
-			}
-			log.ZDebug(
-				newClient.ctx,
-				"get token from redis",
-				"userID",
-				newClient.UserID,
-				"platformID",
-				newClient.PlatformID,
-				"tokenMap",
-				m,
-			)
+	}
+	ws.kickClients(ctx, userID, kickClients)
+	return kickClients
+}
 
-			for k := range m {
-				if k != newClient.ctx.GetToken() {
-					m[k] = constant.KickedToken
-				}
+func (ws *WsServer) kickClients(ctx context.Context, userID string, clients []*Client) {
+	if len(clients) == 0 {
+		return
+	}
+	ws.clients.deleteClients(userID, clients)
+	ws.publishUserRoute(userID)
+	ws.publishUserPresence(userID)
+	for _, c := range clients {
+		log.ZInfo(ctx, "kick old terminal", "userID", userID, "platformID", c.PlatformID, "remoteAddr", c.ctx.GetRemoteAddr())
+		if err := c.KickOnlineMessage(); err != nil {
+			log.ZWarn(ctx, "KickOnlineMessage", err, "userID", userID, "platformID", c.PlatformID)
+		}
+		go func(platformID int) {
+			if err := CallbackUserKickOff(ctx, userID, platformID); err != nil {
+				log.ZWarn(ctx, "CallbackUserKickOff", err, "userID", userID, "platformID", platformID)
 			}
-			log.ZDebug(newClient.ctx, "set token map is ", "token map", m, "userID", newClient.UserID)
-			err = ws.cache.SetTokenMapByUidPid(newClient.ctx, newClient.UserID, newClient.PlatformID, m)
-			if err != nil {
-				log.ZWarn(newClient.ctx, "SetTokenMapByUidPid err", err, "userID", newClient.UserID, "platformID", newClient.PlatformID)
-				return
+		}(c.PlatformID)
+	}
+}
+
+// kickTokens 标记需要被踢下线的平台上的其他token, 包括当前不在线的token, 防止旧token重新连接.
+func (ws *WsServer) kickTokens(ctx context.Context, userID string, platformID int, token string) {
+	if ws.cache == nil {
+		return
+	}
+	for kickPlatformID := range constant.PlatformID2Name {
+		if !needKick(platformID, kickPlatformID) {
+			continue
+		}
+		m, err := ws.cache.GetTokensWithoutError(ctx, userID, kickPlatformID)
+		if err != nil && err != redis.Nil {
+			log.ZWarn(ctx, "get token from redis err", err, "userID", userID, "platformID", kickPlatformID)
+			continue
+		}
+		var changed bool
+		for k, v := range m {
+			if k != token && v == constant.NormalToken {
+				m[k] = constant.KickedToken
+				changed = true
 			}
 		}
+		if !changed {
+			continue
+		}
+		log.ZDebug(ctx, "set token map is ", "token map", m, "userID", userID, "platformID", kickPlatformID)
+		if err := ws.cache.SetTokenMapByUidPid(ctx, userID, kickPlatformID, m); err != nil {
+			log.ZWarn(ctx, "SetTokenMapByUidPid err", err, "userID", userID, "platformID", kickPlatformID)
+		}
+	}
+}
+
+func (ws *WsServer) remoteMultiTerminalLoginCheck(ctx context.Context, userID string, platformID int, token string) {
+	if ws.disCov == nil {
+		return
+	}
+	conns, err := ws.disCov.GetConns(ctx, config.Config.RpcRegisterName.OpenImMessageGatewayName)
+	if err != nil {
+		log.ZWarn(ctx, "get gateway conns failed", err, "userID", userID)
+		return
+	}
+	conns = ws.routeKickGateways(ctx, conns, userID, platformID)
+	req := &msggateway.MultiTerminalLoginCheckReq{
+		UserID:      userID,
+		PlatformID:  int32(platformID),
+		Token:       token,
+		OperationID: mcontext.GetOperationID(ctx),
+	}
+	for _, conn := range conns {
+		resp, err := msggateway.NewMsgGatewayClient(conn).MultiTerminalLoginCheck(ctx, req)
+		if err != nil {
+			log.ZWarn(ctx, "MultiTerminalLoginCheck failed", err, "userID", userID, "platformID", platformID)
+			continue
+		}
+		if len(resp.KickedResp) > 0 {
+			log.ZInfo(ctx, "remote terminals kicked", "userID", userID, "platformID", platformID, "kicked", resp.KickedResp)
+		}
 	}
 }
 
 func (ws *WsServer) unregisterClient(client *Client) {
 	defer ws.clientPool.Put(client)
+	ws.presenceSubs.remove(client)
+	ws.saveSession(client)
+	// 被踢下线的连接已经从map中删除并通知过
+	_, _, platformOK := ws.clients.Get(client.UserID, client.PlatformID)
 	isDeleteUser := ws.clients.delete(client.UserID, client.ctx.GetRemoteAddr())
 	if isDeleteUser {
 		atomic.AddInt64(&ws.onlineUserNum, -1)
 	}
+	ws.publishUserRoute(client.UserID)
+	if _, _, ok := ws.clients.Get(client.UserID, client.PlatformID); platformOK && !ok {
+		ws.publishUserPresence(client.UserID)
+	}
 	atomic.AddInt64(&ws.onlineUserConnNum, -1)
 	log.ZInfo(
 		client.ctx,
@@ -306,16 +434,25 @@ func (ws *WsServer) unregisterClient(client *Client) {
 
 func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	connContext := newContext(w, r)
+	if atomic.LoadInt32(&ws.draining) == 1 {
+		httpError(connContext, errs.ErrGatewayDraining.Wrap())
+		return
+	}
 	if ws.onlineUserConnNum >= ws.wsMaxConnNum {
 		httpError(connContext, errs.ErrConnOverMaxNumLimit)
 		return
 	}
+	if err := ws.checkConnectRateLimit(connContext); err != nil {
+		httpError(connContext, err)
+		return
+	}
 	var (
 		token         string
 		userID        string
 		platformIDStr string
 		exists        bool
 		compression   bool
+		encoding      string
 	)
 
 	token, exists = connContext.Query(Token)
@@ -342,6 +479,10 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		httpError(connContext, err)
 		return
 	}
+	if err := ws.checkRateLimitBan(connContext, userID); err != nil {
+		httpError(connContext, err)
+		return
+	}
 	m, err := ws.cache.GetTokensWithoutError(context.Background(), userID, platformID)
 	if err != nil {
 		httpError(connContext, err)
@@ -361,12 +502,33 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		httpError(connContext, errs.ErrTokenNotExist.Wrap())
 		return
 	}
-	wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout)
-	err = wsLongConn.GenerateLongConn(w, r)
+	if encodingProtoc, exists := connContext.Query(Encoding); exists {
+		encoding = encodingProtoc
+	} else if encodingProtoc, exists = connContext.GetHeader(Encoding); exists {
+		encoding = encodingProtoc
+	}
+	encoder := ws.Encoder
+	if encoding != "" {
+		encoder, err = NewEncoder(encoding)
+		if err != nil {
+			httpError(connContext, errs.ErrConnArgsErr)
+			return
+		}
+	}
+	longConn, err := ws.newLongConn(connContext, token)
+	if err != nil {
+		httpError(connContext, err)
+		return
+	}
+	err = longConn.GenerateLongConn(w, r)
 	if err != nil {
 		httpError(connContext, err)
 		return
 	}
+	httpLongConn, isHttpConn := longConn.(*httpConn)
+	if isHttpConn {
+		ws.httpConns.Store(httpLongConn.connID, httpLongConn)
+	}
 	compressProtoc, exists := connContext.Query(Compression)
 	if exists {
 		if compressProtoc == GzipCompressionProtocol {
@@ -380,7 +542,14 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	client := ws.clientPool.Get().(*Client)
-	client.ResetClient(connContext, wsLongConn, connContext.GetBackground(), compression, ws, token)
+	client.ResetClient(connContext, longConn, connContext.GetBackground(), compression, encoding, encoder, ws, token)
 	ws.registerChan <- client
-	go client.readMessage()
+	go func() {
+		client.waitRoute()
+		client.readMessage()
+	}()
+	if isHttpConn {
+		// 长轮询返回connID, sse在当前请求上持续推送下行消息
+		httpLongConn.serve(w, r)
+	}
 }
@@ -0,0 +1,113 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+)
+
+func TestNeedKick(t *testing.T) {
+	defer func(policy int) { config.Config.MultiLoginPolicy = policy }(config.Config.MultiLoginPolicy)
+	tests := []struct {
+		policy   int
+		newID    int
+		oldID    int
+		needKick bool
+	}{
+		{constant.DefalutNotKick, constant.IOSPlatformID, constant.IOSPlatformID, false},
+		{constant.AllLoginButSameTermKick, constant.IOSPlatformID, constant.IOSPlatformID, true},
+		{constant.AllLoginButSameTermKick, constant.IOSPlatformID, constant.AndroidPlatformID, false},
+		{constant.SingleTerminalLogin, constant.WebPlatformID, constant.WindowsPlatformID, true},
+		{constant.WebAndOther, constant.WebPlatformID, constant.IOSPlatformID, false},
+		{constant.WebAndOther, constant.IOSPlatformID, constant.WebPlatformID, false},
+		{constant.WebAndOther, constant.IOSPlatformID, constant.WindowsPlatformID, true},
+		{constant.PcMobileAndWeb, constant.WindowsPlatformID, constant.OSXPlatformID, true},
+		{constant.PcMobileAndWeb, constant.WindowsPlatformID, constant.IOSPlatformID, false},
+		{constant.PcMobileAndWeb, constant.AndroidPlatformID, constant.IOSPlatformID, true},
+		{constant.PcMobileAndWeb, constant.WebPlatformID, constant.WebPlatformID, false},
+		{constant.PCAndOther, constant.WindowsPlatformID, constant.LinuxPlatformID, false},
+		{constant.PCAndOther, constant.IOSPlatformID, constant.WindowsPlatformID, false},
+		{constant.PCAndOther, constant.IOSPlatformID, constant.WebPlatformID, true},
+	}
+	for _, test := range tests {
+		config.Config.MultiLoginPolicy = test.policy
+		if res := needKick(test.newID, test.oldID); res != test.needKick {
+			t.Errorf("policy %d new %d old %d: need kick %v, got %v", test.policy, test.newID, test.oldID, test.needKick, res)
+		}
+	}
+}
+
+func newTestClient(ws *WsServer, userID string, platformID int, token string) (*Client, *httpConn) {
+	req := httptest.NewRequest("GET", "/?sendID="+userID+"&platformID="+strconv.Itoa(platformID), nil)
+	ctx := newContext(httptest.NewRecorder(), req)
+	ctx.RemoteAddr = token
+	conn := newHttpConn(LongPolling, token, token, nil)
+	_ = conn.SetWriteDeadline(time.Second)
+	client := new(Client)
+	client.ResetClient(ctx, conn, false, false, "", NewGobEncoder(), ws, token)
+	return client, conn
+}
+
+// 踢下线在注册时直接完成, 不依赖事件循环再读取一次.
+func TestRegisterClientKick(t *testing.T) {
+	defer func(policy int) { config.Config.MultiLoginPolicy = policy }(config.Config.MultiLoginPolicy)
+	config.Config.MultiLoginPolicy = constant.SingleTerminalLogin
+	ws := &WsServer{clients: newUserMap(), presenceSubs: newPresenceSubscriber()}
+	old, oldConn := newTestClient(ws, "u1", constant.IOSPlatformID, "t1")
+	ws.registerClient(old)
+	client, _ := newTestClient(ws, "u1", constant.WindowsPlatformID, "t2")
+	ws.registerClient(client)
+	clients, _ := ws.clients.GetAll("u1")
+	if len(clients) != 1 || clients[0] != client {
+		t.Fatalf("clients after kick %v", clients)
+	}
+	if len(oldConn.out) != 1 {
+		t.Fatalf("old conn got %d msgs, want kick msg", len(oldConn.out))
+	}
+}
+
+// 协商json编码且未压缩的连接收到文本帧, 其它编码收到二进制帧.
+func TestWriteMsgFrameType(t *testing.T) {
+	ws := &WsServer{clients: newUserMap(), presenceSubs: newPresenceSubscriber(), Compressor: NewGzipCompressor()}
+	tests := []struct {
+		encoding    string
+		compress    bool
+		messageType int
+	}{
+		{GobEncodingProtocol, false, MessageBinary},
+		{JsonEncodingProtocol, false, MessageText},
+		{JsonEncodingProtocol, true, MessageBinary},
+	}
+	for _, test := range tests {
+		client, conn := newTestClient(ws, "u1", constant.IOSPlatformID, "t1")
+		encoder, err := NewEncoder(test.encoding)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.Encoding, client.encoder, client.IsCompress = test.encoding, encoder, test.compress
+		if err := client.writeBinaryMsg(Resp{ReqIdentifier: WSGetNewestSeq}); err != nil {
+			t.Fatal(err)
+		}
+		if frame := <-conn.out; frame.messageType != test.messageType {
+			t.Errorf("encoding %s compress %v: message type %d, want %d", test.encoding, test.compress, frame.messageType, test.messageType)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const (
+	routeHeartbeatInterval = time.Second * 30
+	// 超过该时间没有心跳的网关, 推送端认为路由表已过期并退回广播
+	routeExpire = routeHeartbeatInterval * 3
+
+	routeHeartbeatBatch = 1000
+	// 新连接等待路由写入的最长时间
+	routeFlushWait = time.Second * 3
+)
+
+// routeUpdates 连接变化后等待发布路由的用户, 由routeFlushLoop批量写入redis, 事件循环中不访问redis.
+type routeUpdates struct {
+	lock sync.Mutex
+	// k: userID, v: 在线状态是否变化, 变化时在路由写入后发布
+	userIDs map[string]bool
+	// 当前批次写入redis后关闭
+	flushed chan struct{}
+	running bool
+	notify  chan struct{}
+}
+
+func newRouteUpdates() *routeUpdates {
+	return &routeUpdates{userIDs: make(map[string]bool), flushed: make(chan struct{}), notify: make(chan struct{}, 1)}
+}
+
+// add 返回包含本次更新的批次写入后关闭的channel, routeFlushLoop未运行时返回nil.
+func (r *routeUpdates) add(userID string, presence bool) chan struct{} {
+	r.lock.Lock()
+	r.userIDs[userID] = r.userIDs[userID] || presence
+	flushed := r.flushed
+	if !r.running {
+		flushed = nil
+	}
+	r.lock.Unlock()
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+	return flushed
+}
+
+func (r *routeUpdates) take() (map[string]bool, chan struct{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	userIDs, flushed := r.userIDs, r.flushed
+	r.userIDs = make(map[string]bool)
+	r.flushed = make(chan struct{})
+	return userIDs, flushed
+}
+
+// SetGatewayAddr 设置本网关注册到服务发现的地址, 并开始发布用户连接路由.
+func (ws *WsServer) SetGatewayAddr(addr string) {
+	ws.gatewayAddr = addr
+	go ws.routeHeartbeat()
+	go ws.routeFlushLoop()
+}
+
+// publishUserRoute 标记用户的连接已变化, 由routeFlushLoop按当时的连接更新路由表, 返回路由写入后关闭的channel.
+func (ws *WsServer) publishUserRoute(userID string) chan struct{} {
+	if ws.routeUpdates == nil {
+		return nil
+	}
+	return ws.routeUpdates.add(userID, false)
+}
+
+// routeFlushLoop 发布连接变化的用户的路由, 写入redis期间新变化的用户在下一批中发布.
+func (ws *WsServer) routeFlushLoop() {
+	ws.routeUpdates.lock.Lock()
+	ws.routeUpdates.running = true
+	ws.routeUpdates.lock.Unlock()
+	for range ws.routeUpdates.notify {
+		ctx := mcontext.NewCtx("routeFlush_" + utils.OperationIDGenerator())
+		updates, flushed := ws.routeUpdates.take()
+		ws.flushUserRoutes(ctx, updates)
+		close(flushed)
+	}
+}
+
+// flushUserRoutes 按本网关上用户当前的连接更新路由表, 用户已没有连接时删除对应路由, 路由写入后发布在线状态的变化.
+func (ws *WsServer) flushUserRoutes(ctx context.Context, updates map[string]bool) {
+	if ws.gatewayAddr == "" || ws.cache == nil {
+		return
+	}
+	var (
+		routes      = make(map[string][]int, routeHeartbeatBatch)
+		presenceIDs []string
+		n           int
+	)
+	for userID, presence := range updates {
+		n++
+		var platformIDs []int
+		clients, _ := ws.clients.GetAll(userID)
+		for _, c := range clients {
+			platformIDs = append(platformIDs, c.PlatformID)
+		}
+		routes[userID] = utils.Distinct(platformIDs)
+		if presence {
+			presenceIDs = append(presenceIDs, userID)
+		}
+		if len(routes) < routeHeartbeatBatch && n < len(updates) {
+			continue
+		}
+		if err := ws.cache.SetUsersRoute(ctx, ws.gatewayAddr, routes, routeExpire); err != nil {
+			log.ZWarn(ctx, "SetUsersRoute failed", err, "userIDs", utils.Keys(routes), "gatewayAddr", ws.gatewayAddr)
+		} else if len(presenceIDs) > 0 && ws.presenceDatabase != nil {
+			if err := ws.presenceDatabase.PublishUsersPresence(ctx, presenceIDs); err != nil {
+				log.ZWarn(ctx, "PublishUsersPresence failed", err, "userIDs", presenceIDs)
+			}
+		}
+		routes = make(map[string][]int, routeHeartbeatBatch)
+		presenceIDs = nil
+	}
+}
+
+// routeHeartbeat 定时刷新网关心跳和本网关所有在线用户的路由.
+func (ws *WsServer) routeHeartbeat() {
+	ticker := time.NewTicker(routeHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		ctx := mcontext.NewCtx("routeHeartbeat_" + utils.OperationIDGenerator())
+		ws.refreshRoutes(ctx)
+		<-ticker.C
+	}
+}
+
+func (ws *WsServer) refreshRoutes(ctx context.Context) {
+	// 先刷新用户路由再刷新心跳, 推送端看到心跳时路由表已是完整的
+	routes := make(map[string][]int, routeHeartbeatBatch)
+	for userID, platformIDs := range ws.clients.GetAllPlatformIDs() {
+		routes[userID] = platformIDs
+		if len(routes) < routeHeartbeatBatch {
+			continue
+		}
+		if err := ws.cache.SetUsersRoute(ctx, ws.gatewayAddr, routes, routeExpire); err != nil {
+			log.ZWarn(ctx, "SetUsersRoute failed", err, "gatewayAddr", ws.gatewayAddr)
+		}
+		routes = make(map[string][]int, routeHeartbeatBatch)
+	}
+	if err := ws.cache.SetUsersRoute(ctx, ws.gatewayAddr, routes, routeExpire); err != nil {
+		log.ZWarn(ctx, "SetUsersRoute failed", err, "gatewayAddr", ws.gatewayAddr)
+	}
+	if err := ws.cache.SetGatewayHeartbeat(ctx, ws.gatewayAddr, routeExpire); err != nil {
+		log.ZWarn(ctx, "SetGatewayHeartbeat failed", err, "gatewayAddr", ws.gatewayAddr)
+	}
+}
+
+// routeKickGateways 按路由表只返回持有该用户冲突平台连接的其他网关, 路由表不可信时返回全部网关.
+func (ws *WsServer) routeKickGateways(ctx context.Context, conns []grpc.ClientConnInterface, userID string, platformID int) []grpc.ClientConnInterface {
+	if ws.gatewayAddr == "" || ws.cache == nil {
+		return conns
+	}
+	addrConns := make(map[string]grpc.ClientConnInterface, len(conns))
+	for _, v := range conns {
+		cc, ok := v.(*grpc.ClientConn)
+		if !ok {
+			return conns
+		}
+		addrConns[cc.Target()] = v
+	}
+	aliveAddrs, err := ws.cache.GetAliveGateways(ctx, utils.Keys(addrConns))
+	if err != nil {
+		log.ZWarn(ctx, "GetAliveGateways failed", err)
+		return conns
+	}
+	if len(aliveAddrs) != len(addrConns) {
+		return conns
+	}
+	routes, err := ws.cache.GetUsersRoutes(ctx, []string{userID})
+	if err != nil {
+		log.ZWarn(ctx, "GetUsersRoutes failed", err, "userID", userID)
+		return conns
+	}
+	var res []grpc.ClientConnInterface
+	for addr, platformIDs := range routes[userID] {
+		conn, ok := addrConns[addr]
+		if !ok || addr == ws.gatewayAddr {
+			continue
+		}
+		for _, oldPlatformID := range platformIDs {
+			if needKick(platformID, oldPlatformID) {
+				res = append(res, conn)
+				break
+			}
+		}
+	}
+	return res
+}
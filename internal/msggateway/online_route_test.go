@@ -0,0 +1,155 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
+)
+
+type routeCache struct {
+	cache.MsgModel
+	entered chan struct{}
+	block   chan struct{}
+	lock    sync.Mutex
+	batches [][]string
+	routes  map[string][]int
+}
+
+func (c *routeCache) SetUsersRoute(ctx context.Context, gatewayAddr string, routes map[string][]int, expire time.Duration) error {
+	select {
+	case c.entered <- struct{}{}:
+	default:
+	}
+	<-c.block
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var userIDs []string
+	for userID, platformIDs := range routes {
+		userIDs = append(userIDs, userID)
+		c.routes[userID] = platformIDs
+	}
+	sort.Strings(userIDs)
+	c.batches = append(c.batches, userIDs)
+	return nil
+}
+
+// routePresence 记录发布状态时用户的路由是否已经写入.
+type routePresence struct {
+	controller.PresenceDatabase
+	rc        *routeCache
+	published chan []int
+}
+
+func (p *routePresence) PublishUsersPresence(ctx context.Context, userIDs []string) error {
+	p.rc.lock.Lock()
+	defer p.rc.lock.Unlock()
+	for _, userID := range userIDs {
+		p.published <- p.rc.routes[userID]
+	}
+	return nil
+}
+
+func (c *routeCache) waitBatches(t *testing.T, n int) [][]string {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		c.lock.Lock()
+		batches := c.batches
+		c.lock.Unlock()
+		if len(batches) >= n {
+			return batches
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("routes not flushed, want %d batches", n)
+	return nil
+}
+
+// 事件循环只标记用户, redis较慢时期间变化的用户合并为一批发布.
+func TestPublishUserRoute(t *testing.T) {
+	rc := &routeCache{entered: make(chan struct{}, 1), block: make(chan struct{}), routes: make(map[string][]int)}
+	ws := &WsServer{
+		clients:      newUserMap(),
+		presenceSubs: newPresenceSubscriber(),
+		routeUpdates: newRouteUpdates(),
+		gatewayAddr:  "gw1",
+		cache:        rc,
+	}
+	ws.routeUpdates.running = true
+	go ws.routeFlushLoop()
+	for _, userID := range []string{"u1", "u2", "u3"} {
+		client, _ := newTestClient(ws, userID, constant.IOSPlatformID, "t_"+userID)
+		ws.clients.Set(userID, client)
+	}
+	flushed := ws.publishUserRoute("u1")
+	select {
+	case <-rc.entered:
+	case <-time.After(time.Second):
+		t.Fatal("route flush not started")
+	}
+	done := make(chan struct{})
+	go func() {
+		ws.publishUserRoute("u2")
+		ws.publishUserRoute("u3")
+		ws.publishUserRoute("u2")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publishUserRoute blocked by redis")
+	}
+	close(rc.block)
+	select {
+	case <-flushed:
+	case <-time.After(time.Second):
+		t.Fatal("flushed not closed after route written")
+	}
+	batches := rc.waitBatches(t, 2)
+	if len(batches) != 2 || len(batches[1]) != 2 || batches[1][0] != "u2" || batches[1][1] != "u3" {
+		t.Fatalf("batches %v", batches)
+	}
+
+	// 在线状态在路由写入后发布
+	presence := &routePresence{rc: rc, published: make(chan []int, 1)}
+	ws.presenceDatabase = presence
+	ws.clients.DeleteAll("u1")
+	ws.publishUserRoute("u1")
+	ws.publishUserPresence("u1")
+	select {
+	case platformIDs := <-presence.published:
+		if len(platformIDs) != 0 {
+			t.Fatalf("presence published before route deleted, route %v", platformIDs)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("presence not published")
+	}
+	rc.waitBatches(t, 3)
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	if platformIDs, ok := rc.routes["u1"]; !ok || len(platformIDs) != 0 {
+		t.Fatalf("route of offline user %v, want deleted", platformIDs)
+	}
+	if platformIDs := rc.routes["u2"]; len(platformIDs) != 1 || platformIDs[0] != constant.IOSPlatformID {
+		t.Fatalf("route of u2 %v", platformIDs)
+	}
+}
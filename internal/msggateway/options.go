@@ -27,6 +27,10 @@ type (
 		handshakeTimeout time.Duration
 		// 允许消息最大长度
 		messageMaxMsgLength int
+		// 断线后可以恢复会话的时间
+		resumeWindow time.Duration
+		// 网关下线时客户端随机重连等待的最大时间
+		reconnectBackoff time.Duration
 	}
 )
 
@@ -53,3 +57,15 @@ func WithMessageMaxMsgLength(length int) Option {
 		opt.messageMaxMsgLength = length
 	}
 }
+
+func WithResumeWindow(t time.Duration) Option {
+	return func(opt *configs) {
+		opt.resumeWindow = t
+	}
+}
+
+func WithReconnectBackoff(t time.Duration) Option {
+	return func(opt *configs) {
+		opt.reconnectBackoff = t
+	}
+}
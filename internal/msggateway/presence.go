@@ -0,0 +1,158 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+// 单个连接最多订阅的用户数
+const maxPresenceSubscriptions = 1000
+
+// presenceSubscriber 记录本网关上的连接订阅了哪些用户的在线状态, 订阅随连接断开失效.
+type presenceSubscriber struct {
+	lock sync.RWMutex
+	// k: 被订阅的userID
+	subs map[string]map[*Client]struct{}
+	// k: 订阅者连接, v: 被订阅的userID
+	clients map[*Client]map[string]struct{}
+}
+
+func newPresenceSubscriber() *presenceSubscriber {
+	return &presenceSubscriber{
+		subs:    make(map[string]map[*Client]struct{}),
+		clients: make(map[*Client]map[string]struct{}),
+	}
+}
+
+// update 返回新增订阅的userID.
+func (p *presenceSubscriber) update(client *Client, subscribeUserIDs, unsubscribeUserIDs []string) ([]string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	userIDs := p.clients[client]
+	if userIDs == nil {
+		userIDs = make(map[string]struct{})
+	}
+	for _, userID := range unsubscribeUserIDs {
+		delete(userIDs, userID)
+		p.delSub(userID, client)
+	}
+	var added []string
+	for _, userID := range subscribeUserIDs {
+		if _, ok := userIDs[userID]; !ok {
+			added = append(added, userID)
+		}
+	}
+	if len(userIDs)+len(added) > maxPresenceSubscriptions {
+		return nil, errs.ErrArgs.Wrap("subscribe users exceed limit")
+	}
+	for _, userID := range added {
+		userIDs[userID] = struct{}{}
+		if p.subs[userID] == nil {
+			p.subs[userID] = make(map[*Client]struct{})
+		}
+		p.subs[userID][client] = struct{}{}
+	}
+	if len(userIDs) == 0 {
+		delete(p.clients, client)
+	} else {
+		p.clients[client] = userIDs
+	}
+	return added, nil
+}
+
+func (p *presenceSubscriber) remove(client *Client) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for userID := range p.clients[client] {
+		p.delSub(userID, client)
+	}
+	delete(p.clients, client)
+}
+
+func (p *presenceSubscriber) delSub(userID string, client *Client) {
+	delete(p.subs[userID], client)
+	if len(p.subs[userID]) == 0 {
+		delete(p.subs, userID)
+	}
+}
+
+func (p *presenceSubscriber) getSubscribers(userID string) []*Client {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	clients := make([]*Client, 0, len(p.subs[userID]))
+	for client := range p.subs[userID] {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
+func (ws *WsServer) SubscribeUsersPresence(ctx context.Context, client *Client, data Req) ([]byte, error) {
+	var req sdkws.SubscribeUsersPresenceReq
+	if err := proto.Unmarshal(data.Data, &req); err != nil {
+		return nil, err
+	}
+	if req.UserID != client.UserID {
+		return nil, errs.ErrNoPermission.Wrap("userID not same to conn userID")
+	}
+	subscribeUserIDs := utils.Distinct(req.SubscribeUserIDs)
+	if len(subscribeUserIDs) > constant.PresenceMaxUserIDs {
+		return nil, errs.ErrArgs.Wrap("subscribe users exceed limit")
+	}
+	var presences []*sdkws.UserPresence
+	if len(subscribeUserIDs) > 0 {
+		// user rpc校验好友或同群关系, 校验通过后才订阅
+		var err error
+		presences, err = ws.userRpcClient.GetUsersPresence(ctx, subscribeUserIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := ws.presenceSubs.update(client, subscribeUserIDs, req.UnsubscribeUserIDs); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&sdkws.SubscribeUsersPresenceResp{Presences: presences})
+}
+
+// publishUserPresence 用户在本网关上的在线平台变化后, 由routeFlushLoop在路由写入后通知所有网关上的订阅者.
+// 用户状态由路由表生成, 先于路由写入发布会得到旧的状态.
+func (ws *WsServer) publishUserPresence(userID string) {
+	if ws.routeUpdates == nil {
+		return
+	}
+	ws.routeUpdates.add(userID, true)
+}
+
+// presenceLoop 接收所有网关和服务广播的用户状态, 推送给本网关上的订阅者.
+func (ws *WsServer) presenceLoop() {
+	ctx := mcontext.NewCtx("presenceLoop_" + utils.OperationIDGenerator())
+	for presence := range ws.presenceDatabase.SubscribeUsersPresence(ctx) {
+		for _, client := range ws.presenceSubs.getSubscribers(presence.UserID) {
+			if err := client.PushPresence(ctx, presence); err != nil {
+				log.ZWarn(ctx, "PushPresence failed", err, "userID", client.UserID, "presenceUserID", presence.UserID)
+			}
+		}
+	}
+}
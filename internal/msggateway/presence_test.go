@@ -0,0 +1,51 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestPresenceSubscriber(t *testing.T) {
+	p := newPresenceSubscriber()
+	c1, c2 := &Client{UserID: "a"}, &Client{UserID: "b"}
+	if added, err := p.update(c1, []string{"x", "y"}, nil); err != nil || len(added) != 2 {
+		t.Fatalf("added %v, err %v", added, err)
+	}
+	if added, err := p.update(c2, []string{"x"}, nil); err != nil || len(added) != 1 {
+		t.Fatalf("added %v, err %v", added, err)
+	}
+	if added, _ := p.update(c1, []string{"x"}, []string{"y"}); len(added) != 0 {
+		t.Errorf("resubscribe should add nothing, got %v", added)
+	}
+	if n := len(p.getSubscribers("x")); n != 2 {
+		t.Errorf("x subscribers %d", n)
+	}
+	if n := len(p.getSubscribers("y")); n != 0 {
+		t.Errorf("y subscribers %d", n)
+	}
+	p.remove(c1)
+	if subs := p.getSubscribers("x"); len(subs) != 1 || subs[0] != c2 {
+		t.Errorf("x subscribers %v", subs)
+	}
+	userIDs := make([]string, maxPresenceSubscriptions+1)
+	for i := range userIDs {
+		userIDs[i] = strconv.Itoa(i)
+	}
+	if _, err := p.update(c1, userIDs, nil); err == nil {
+		t.Errorf("subscribe over limit should fail")
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/prome"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+var ErrRateLimitKicked = errors.New("conn closed for exceeding rate limit repeatedly")
+
+// 本网关上用户的令牌桶超过该时间未使用后回收
+const userLimiterIdle = time.Minute * 5
+
+// userRateLimiter 用户在本网关上所有连接共享的令牌桶.
+type userRateLimiter struct {
+	user     *rate.Limiter
+	reqs     map[int32]*rate.Limiter
+	lastUsed time.Time
+}
+
+// rateLimiters 本网关的令牌桶, 只在内存中计算.
+// 用户的令牌桶同时作为redis中共享令牌桶的本地预检查, 本地已经超限的请求不再访问redis.
+type rateLimiters struct {
+	lock      sync.Mutex
+	connect   *rate.Limiter
+	users     map[string]*userRateLimiter
+	lastSweep time.Time
+}
+
+func newRateLimiters() *rateLimiters {
+	return &rateLimiters{users: make(map[string]*userRateLimiter)}
+}
+
+// newLimiter r不大于0表示不限制, 返回nil.
+func newLimiter(r int, burst int) *rate.Limiter {
+	if r <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(r), utils.Max(burst, 1))
+}
+
+// reserve 取不到令牌时取消预留并返回nil.
+func reserve(limiter *rate.Limiter, now time.Time) (*rate.Reservation, bool) {
+	if limiter == nil {
+		return nil, true
+	}
+	r := limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return nil, false
+	}
+	if r.DelayFrom(now) > 0 {
+		r.CancelAt(now)
+		return nil, false
+	}
+	return r, true
+}
+
+// allowUser 同时从用户的总令牌桶和用户在该请求类型上的令牌桶取令牌, 都有令牌时才扣减.
+func (l *rateLimiters) allowUser(userID string, reqIdentifier int32, now time.Time) bool {
+	conf := &config.Config.LongConnSvr.RateLimit
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.sweep(now)
+	u, ok := l.users[userID]
+	if !ok {
+		u = &userRateLimiter{user: newLimiter(conf.UserRate, conf.UserBurst), reqs: make(map[int32]*rate.Limiter)}
+		l.users[userID] = u
+	}
+	u.lastUsed = now
+	req, ok := u.reqs[reqIdentifier]
+	if !ok {
+		req = reqLimiter(reqIdentifier)
+		u.reqs[reqIdentifier] = req
+	}
+	userReservation, ok := reserve(u.user, now)
+	if !ok {
+		return false
+	}
+	if _, ok := reserve(req, now); !ok {
+		if userReservation != nil {
+			userReservation.CancelAt(now)
+		}
+		return false
+	}
+	return true
+}
+
+func (l *rateLimiters) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < userLimiterIdle {
+		return
+	}
+	l.lastSweep = now
+	for userID, u := range l.users {
+		if now.Sub(u.lastUsed) > userLimiterIdle {
+			delete(l.users, userID)
+		}
+	}
+}
+
+func (l *rateLimiters) allowConnect(now time.Time) bool {
+	conf := &config.Config.LongConnSvr.RateLimit
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.connect == nil {
+		if l.connect = newLimiter(conf.ConnectRate, conf.ConnectBurst); l.connect == nil {
+			return true
+		}
+	}
+	return l.connect.AllowN(now, 1)
+}
+
+func reqLimiter(reqIdentifier int32) *rate.Limiter {
+	bucket := reqBucket(reqIdentifier)
+	return newLimiter(bucket.Rate, bucket.Burst)
+}
+
+func reqBucket(reqIdentifier int32) cache.TokenBucket {
+	for _, v := range config.Config.LongConnSvr.RateLimit.ReqLimits {
+		if v.ReqIdentifier == reqIdentifier {
+			return cache.TokenBucket{Rate: v.Rate, Burst: v.Burst}
+		}
+	}
+	return cache.TokenBucket{}
+}
+
+// allowUserShared 从redis中用户的令牌桶取令牌, 多个网关实例共享限额, redis不可用时只按本地令牌桶限制.
+func (ws *WsServer) allowUserShared(ctx context.Context, client *Client, reqIdentifier int32) bool {
+	if ws.cache == nil {
+		return true
+	}
+	conf := &config.Config.LongConnSvr.RateLimit
+	user := cache.TokenBucket{Rate: conf.UserRate, Burst: conf.UserBurst}
+	ok, err := ws.cache.TakeUserRateLimitToken(ctx, client.UserID, reqIdentifier, user, reqBucket(reqIdentifier))
+	if err != nil {
+		log.ZWarn(ctx, "TakeUserRateLimitToken failed", err, "userID", client.UserID, "reqIdentifier", reqIdentifier)
+		return true
+	}
+	return ok
+}
+
+func (ws *WsServer) initRateLimitPrometheus() {
+	prome.NewMsgGatewayRateLimitedCounter()
+	prome.NewMsgGatewayConnectRateLimitedCounter()
+	prome.NewMsgGatewayRateLimitKickedCounter()
+}
+
+// CheckRateLimit 依次检查连接, 用户和用户在该请求类型上的令牌桶.
+// 用户级别的令牌桶先在本网关上检查, 通过后再从redis中所有网关共享的令牌桶取令牌.
+// disconnect为true表示连接在时间窗口内多次超限, 需要断开.
+func (ws *WsServer) CheckRateLimit(ctx context.Context, client *Client, reqIdentifier int32) (disconnect bool, err error) {
+	conf := &config.Config.LongConnSvr.RateLimit
+	if !conf.Enable {
+		return false, nil
+	}
+	if client.limiter == nil && conf.ConnRate > 0 {
+		client.limiter = newLimiter(conf.ConnRate, conf.ConnBurst)
+	}
+	now := time.Now()
+	if (client.limiter == nil || client.limiter.AllowN(now, 1)) && ws.rateLimiters.allowUser(client.UserID, reqIdentifier, now) &&
+		ws.allowUserShared(ctx, client, reqIdentifier) {
+		return false, nil
+	}
+	prome.Inc(prome.MsgGatewayRateLimitedCounter)
+	log.ZInfo(ctx, "request rate limited", "userID", client.UserID, "platformID", client.PlatformID, "reqIdentifier", reqIdentifier)
+	return ws.recordRateLimitViolation(ctx, client), errs.ErrRateLimitExceeded.Wrap()
+}
+
+// recordRateLimitViolation 记录用户被限流的次数, 超过maxViolations时禁止用户在banTime内重新连接.
+func (ws *WsServer) recordRateLimitViolation(ctx context.Context, client *Client) bool {
+	conf := &config.Config.LongConnSvr.RateLimit
+	if conf.MaxViolations <= 0 || ws.cache == nil {
+		return false
+	}
+	n, err := ws.cache.IncrRateLimitViolation(ctx, client.UserID, time.Duration(conf.ViolationWindow)*time.Second)
+	if err != nil {
+		log.ZWarn(ctx, "IncrRateLimitViolation failed", err, "userID", client.UserID)
+		return false
+	}
+	if n <= int64(conf.MaxViolations) {
+		return false
+	}
+	if conf.BanTime > 0 {
+		if err := ws.cache.SetRateLimitBan(ctx, client.UserID, time.Duration(conf.BanTime)*time.Second); err != nil {
+			log.ZWarn(ctx, "SetRateLimitBan failed", err, "userID", client.UserID)
+		}
+	}
+	prome.Inc(prome.MsgGatewayRateLimitKickedCounter)
+	log.ZWarn(ctx, "conn exceed rate limit repeatedly, disconnect", nil, "userID", client.UserID, "platformID", client.PlatformID, "violations", n)
+	return true
+}
+
+// checkConnectRateLimit 限制本网关每秒建立的新连接数.
+func (ws *WsServer) checkConnectRateLimit(ctx context.Context) error {
+	if !config.Config.LongConnSvr.RateLimit.Enable {
+		return nil
+	}
+	if !ws.rateLimiters.allowConnect(time.Now()) {
+		prome.Inc(prome.MsgGatewayConnectRateLimitedCounter)
+		return errs.ErrRateLimitExceeded.Wrap("too many connections")
+	}
+	return nil
+}
+
+// checkRateLimitBan 多次超限被断开的用户在禁止时间内不能重新连接.
+func (ws *WsServer) checkRateLimitBan(ctx context.Context, userID string) error {
+	if !config.Config.LongConnSvr.RateLimit.Enable || ws.cache == nil {
+		return nil
+	}
+	banned, err := ws.cache.IsRateLimitBanned(ctx, userID)
+	if err != nil {
+		log.ZWarn(ctx, "IsRateLimitBanned failed", err, "userID", userID)
+		return nil
+	}
+	if banned {
+		return errs.ErrRateLimitExceeded.Wrap("conn banned for exceeding rate limit")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
+
+func TestCheckRateLimitConn(t *testing.T) {
+	defer func(enable bool, rate, burst int) {
+		config.Config.LongConnSvr.RateLimit.Enable = enable
+		config.Config.LongConnSvr.RateLimit.ConnRate = rate
+		config.Config.LongConnSvr.RateLimit.ConnBurst = burst
+	}(config.Config.LongConnSvr.RateLimit.Enable, config.Config.LongConnSvr.RateLimit.ConnRate, config.Config.LongConnSvr.RateLimit.ConnBurst)
+	config.Config.LongConnSvr.RateLimit.Enable = true
+	config.Config.LongConnSvr.RateLimit.ConnRate = 1
+	config.Config.LongConnSvr.RateLimit.ConnBurst = 2
+	ws := &WsServer{rateLimiters: newRateLimiters()}
+	client := &Client{UserID: "u"}
+	for i := 0; i < 2; i++ {
+		if _, err := ws.CheckRateLimit(context.Background(), client, WSSendMsg); err != nil {
+			t.Fatalf("request %d limited: %v", i, err)
+		}
+	}
+	disconnect, err := ws.CheckRateLimit(context.Background(), client, WSSendMsg)
+	if errs.Unwrap(err) != errs.ErrRateLimitExceeded {
+		t.Errorf("want rate limit error, got %v", err)
+	}
+	if disconnect {
+		t.Errorf("should not disconnect without violation tracking")
+	}
+}
+
+// 同一用户在本网关上的连接共享用户令牌桶.
+func TestCheckRateLimitUser(t *testing.T) {
+	conf := &config.Config.LongConnSvr.RateLimit
+	old := *conf
+	defer func() { *conf = old }()
+	conf.Enable = true
+	conf.ConnRate = 0
+	conf.UserRate = 1
+	conf.UserBurst = 2
+	conf.ConnectRate = 1
+	conf.ConnectBurst = 1
+	ws := &WsServer{rateLimiters: newRateLimiters()}
+	clients := []*Client{{UserID: "u"}, {UserID: "u"}}
+	for _, client := range clients {
+		if _, err := ws.CheckRateLimit(context.Background(), client, WSSendMsg); err != nil {
+			t.Fatalf("request limited: %v", err)
+		}
+	}
+	if _, err := ws.CheckRateLimit(context.Background(), clients[0], WSSendMsg); errs.Unwrap(err) != errs.ErrRateLimitExceeded {
+		t.Errorf("want rate limit error, got %v", err)
+	}
+	if _, err := ws.CheckRateLimit(context.Background(), &Client{UserID: "other"}, WSSendMsg); err != nil {
+		t.Errorf("other user limited: %v", err)
+	}
+	if err := ws.checkConnectRateLimit(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.checkConnectRateLimit(context.Background()); errs.Unwrap(err) != errs.ErrRateLimitExceeded {
+		t.Errorf("want connect rate limit error, got %v", err)
+	}
+}
+
+type sharedRateLimitCache struct {
+	cache.MsgModel
+	allow bool
+	taken int
+}
+
+func (c *sharedRateLimitCache) TakeUserRateLimitToken(ctx context.Context, userID string, reqIdentifier int32, user cache.TokenBucket, req cache.TokenBucket) (bool, error) {
+	c.taken++
+	return c.allow, nil
+}
+
+// 本地预检查通过后由redis中共享的令牌桶决定, 本地已经超限时不访问redis.
+func TestCheckRateLimitShared(t *testing.T) {
+	conf := &config.Config.LongConnSvr.RateLimit
+	old := *conf
+	defer func() { *conf = old }()
+	conf.Enable = true
+	conf.ConnRate = 0
+	conf.UserRate = 1
+	conf.UserBurst = 1
+	conf.MaxViolations = 0
+	shared := &sharedRateLimitCache{}
+	ws := &WsServer{rateLimiters: newRateLimiters(), cache: shared}
+	if _, err := ws.CheckRateLimit(context.Background(), &Client{UserID: "u"}, WSSendMsg); errs.Unwrap(err) != errs.ErrRateLimitExceeded {
+		t.Errorf("want rate limit error, got %v", err)
+	}
+	if _, err := ws.CheckRateLimit(context.Background(), &Client{UserID: "u"}, WSSendMsg); errs.Unwrap(err) != errs.ErrRateLimitExceeded {
+		t.Errorf("want rate limit error, got %v", err)
+	}
+	if shared.taken != 1 {
+		t.Errorf("want 1 redis call, got %d", shared.taken)
+	}
+	shared.allow = true
+	if _, err := ws.CheckRateLimit(context.Background(), &Client{UserID: "other"}, WSSendMsg); err != nil {
+		t.Errorf("other user limited: %v", err)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/shutdown"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+// clientSession 记录连接已发送给客户端的每个会话的最大seq, 只在客户端申请了恢复凭证后记录.
+type clientSession struct {
+	lock  sync.Mutex
+	token string
+	// k: conversationID
+	seqs map[string]int64
+}
+
+func newClientSession() *clientSession {
+	return &clientSession{}
+}
+
+func (s *clientSession) enabled() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.token != ""
+}
+
+// start 设置新的恢复凭证, seqs为恢复的上一个连接的状态.
+func (s *clientSession) start(token string, seqs map[string]int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.token = token
+	if s.seqs == nil {
+		s.seqs = make(map[string]int64, len(seqs))
+	}
+	for conversationID, seq := range seqs {
+		if seq > s.seqs[conversationID] {
+			s.seqs[conversationID] = seq
+		}
+	}
+}
+
+func (s *clientSession) ack(conversationID string, seq int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.token == "" || seq <= s.seqs[conversationID] {
+		return
+	}
+	s.seqs[conversationID] = seq
+}
+
+func (s *clientSession) ackPullMsgs(m map[string]*sdkws.PullMsgs) {
+	for conversationID, pullMsgs := range m {
+		var maxSeq int64
+		for _, msg := range pullMsgs.Msgs {
+			if msg != nil && msg.Seq > maxSeq {
+				maxSeq = msg.Seq
+			}
+		}
+		s.ack(conversationID, maxSeq)
+	}
+}
+
+// ackReply 记录已成功回复给客户端的消息的seq, 只有拉取消息的回复包含消息.
+// 获取maxSeq的回复不代表客户端收到了这些消息, 不作为已发送记录.
+func (s *clientSession) ackReply(reqIdentifier int32, data []byte) {
+	if !s.enabled() {
+		return
+	}
+	switch reqIdentifier {
+	case WSPullMsgBySeqList:
+		var resp sdkws.PullMessageBySeqsResp
+		if err := proto.Unmarshal(data, &resp); err != nil {
+			return
+		}
+		s.ackPullMsgs(resp.Msgs)
+		s.ackPullMsgs(resp.NotificationMsgs)
+	}
+}
+
+func (s *clientSession) snapshot() (token string, seqs map[string]int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	seqs = make(map[string]int64, len(s.seqs))
+	for conversationID, seq := range s.seqs {
+		seqs[conversationID] = seq
+	}
+	return s.token, seqs
+}
+
+func genResumeToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ResumeSession 为连接签发恢复凭证, 携带有效的旧凭证时返回断开期间maxSeq增长的会话.
+func (ws *WsServer) ResumeSession(ctx context.Context, client *Client, data Req) ([]byte, error) {
+	var req sdkws.ResumeSessionReq
+	if err := proto.Unmarshal(data.Data, &req); err != nil {
+		return nil, err
+	}
+	resp := &sdkws.ResumeSessionResp{}
+	if ws.resumeWindow <= 0 {
+		return proto.Marshal(resp)
+	}
+	if client.session.enabled() {
+		return nil, errs.ErrArgs.Wrap("session already started")
+	}
+	var seqs map[string]int64
+	if req.ResumeToken != "" {
+		userID, platformID, oldSeqs, err := ws.cache.TakeResumeSession(ctx, req.ResumeToken)
+		if err != nil && errs.Unwrap(err) != redis.Nil {
+			return nil, err
+		}
+		if err == nil && userID == client.UserID && platformID == client.PlatformID {
+			seqs = oldSeqs
+			resp.Resumed = true
+		} else {
+			log.ZInfo(ctx, "resume token invalid or expired", "userID", client.UserID, "platformID", client.PlatformID)
+		}
+	}
+	if resp.Resumed {
+		conversationSeqs, err := ws.msgRpcClient.GetConversationsHasReadAndMaxSeq(ctx, client.UserID)
+		if err != nil {
+			return nil, err
+		}
+		for conversationID, v := range conversationSeqs {
+			// 上一个连接没有发送过的会话, 以已读seq作为客户端已有的位置
+			ackSeq, ok := seqs[conversationID]
+			if !ok {
+				ackSeq = v.HasReadSeq
+			}
+			if v.MaxSeq <= ackSeq {
+				continue
+			}
+			resp.Conversations = append(resp.Conversations, &sdkws.ConversationCatchUp{
+				ConversationID: conversationID,
+				AckSeq:         ackSeq,
+				MaxSeq:         v.MaxSeq,
+				HasReadSeq:     v.HasReadSeq,
+			})
+		}
+	}
+	token, err := genResumeToken()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	// 补发的会话在客户端拉取后才会更新seq
+	client.session.start(token, seqs)
+	resp.ResumeToken = token
+	resp.ResumeWindow = int64(ws.resumeWindow.Seconds())
+	return proto.Marshal(resp)
+}
+
+// saveSession 连接断开时保存会话状态, 供客户端在resumeWindow内恢复.
+func (ws *WsServer) saveSession(client *Client) {
+	token, seqs := client.session.snapshot()
+	if token == "" || ws.cache == nil {
+		return
+	}
+	userID, platformID := client.UserID, client.PlatformID
+	ctx := mcontext.WithMustInfoCtx(
+		[]string{client.ctx.GetOperationID(), userID, constant.PlatformIDToName(platformID), client.ctx.GetConnID()},
+	)
+	shutdown.Go(func() {
+		if err := ws.cache.SetResumeSession(ctx, token, userID, platformID, seqs, ws.resumeWindow); err != nil {
+			log.ZWarn(ctx, "SetResumeSession failed", err, "userID", userID, "platformID", platformID)
+		}
+	})
+}
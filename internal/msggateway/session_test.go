@@ -0,0 +1,50 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+func TestClientSessionAck(t *testing.T) {
+	s := newClientSession()
+	s.ack("si_a_b", 10)
+	if _, seqs := s.snapshot(); len(seqs) != 0 {
+		t.Fatalf("session not started, seqs %v", seqs)
+	}
+	s.start("token", map[string]int64{"si_a_b": 5, "sg_g": 7})
+	s.ack("si_a_b", 8)
+	s.ack("sg_g", 3)
+	pull, _ := proto.Marshal(&sdkws.PullMessageBySeqsResp{Msgs: map[string]*sdkws.PullMsgs{
+		"sg_g": {Msgs: []*sdkws.MsgData{{Seq: 9}, {Seq: 12}, nil}},
+	}})
+	s.ackReply(WSPullMsgBySeqList, pull)
+	maxSeq, _ := proto.Marshal(&sdkws.GetMaxSeqResp{MaxSeqs: map[string]int64{"n_x": 4}})
+	s.ackReply(WSGetNewestSeq, maxSeq)
+	token, seqs := s.snapshot()
+	if token != "token" {
+		t.Errorf("token %s", token)
+	}
+	want := map[string]int64{"si_a_b": 8, "sg_g": 12, "n_x": 0}
+	for conversationID, seq := range want {
+		if seqs[conversationID] != seq {
+			t.Errorf("%s: want %d, got %d", conversationID, seq, seqs[conversationID])
+		}
+	}
+}
@@ -115,6 +115,30 @@ func (u *UserMap) deleteClients(key string, clients []*Client) (isDeleteUser boo
 	return existed
 }
 
+// GetAllClients 返回所有用户的全部连接
+func (u *UserMap) GetAllClients() []*Client {
+	var clients []*Client
+	u.m.Range(func(key, value any) bool {
+		clients = append(clients, value.([]*Client)...)
+		return true
+	})
+	return clients
+}
+
+// GetAllPlatformIDs k: userID, v: 用户在线的平台
+func (u *UserMap) GetAllPlatformIDs() map[string][]int {
+	m := make(map[string][]int)
+	u.m.Range(func(key, value any) bool {
+		var platformIDs []int
+		for _, client := range value.([]*Client) {
+			platformIDs = append(platformIDs, client.PlatformID)
+		}
+		m[key.(string)] = utils.Distinct(platformIDs)
+		return true
+	})
+	return m
+}
+
 func (u *UserMap) DeleteAll(key string) {
 	u.m.Delete(key)
 }
@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgtransfer
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	pbMsg "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+const maxRetryBackoff = 5 * time.Second
+
+// retryWithBackoff 执行fn, 失败后按指数退避最多重试kafka.deadLetter.maxRetry次, 返回尝试次数和最后一次的错误.
+// 退避期间ctx取消时不再重试.
+func retryWithBackoff(ctx context.Context, fn func() error) (attempts int32, err error) {
+	backoff := time.Duration(config.Config.Kafka.DeadLetter.RetryBackoff) * time.Millisecond
+	for {
+		attempts++
+		if err = fn(); err == nil {
+			return attempts, nil
+		}
+		if int(attempts) > config.Config.Kafka.DeadLetter.MaxRetry {
+			return attempts, err
+		}
+		log.ZWarn(ctx, "retry after failure", err, "attempts", attempts, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return attempts, err
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+// toDeadLetter 重试后仍失败的批次写入死信topic, 写入也失败时只能记录日志.
+func toDeadLetter(
+	ctx context.Context,
+	msgDatabase controller.CommonMsgDatabase,
+	stage, key, conversationID string,
+	msgs []*sdkws.MsgData,
+	lastSeq int64,
+	attempts int32,
+	cause error,
+) {
+	deadLetter := &pbMsg.DeadLetterMsg{
+		Stage:          stage,
+		Key:            key,
+		ConversationID: conversationID,
+		MsgData:        msgs,
+		LastSeq:        lastSeq,
+		ErrMsg:         cause.Error(),
+		Attempts:       attempts,
+		FailedTime:     time.Now().UnixMilli(),
+		OperationID:    mcontext.GetOperationID(ctx),
+	}
+	if err := msgDatabase.MsgToDeadLetterMQ(ctx, deadLetter); err != nil {
+		log.ZError(ctx, "msg to dead letter mq failed, msgs lost", err, "stage", stage, "conversationID", conversationID, "msgs", msgs)
+		return
+	}
+	log.ZWarn(ctx, "msgs moved to dead letter", cause, "stage", stage, "conversationID", conversationID, "len", len(msgs), "attempts", attempts)
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgtransfer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+)
+
+func TestRetryWithBackoff(t *testing.T) {
+	defer func(maxRetry, backoff int) {
+		config.Config.Kafka.DeadLetter.MaxRetry = maxRetry
+		config.Config.Kafka.DeadLetter.RetryBackoff = backoff
+	}(config.Config.Kafka.DeadLetter.MaxRetry, config.Config.Kafka.DeadLetter.RetryBackoff)
+	config.Config.Kafka.DeadLetter.MaxRetry = 2
+	config.Config.Kafka.DeadLetter.RetryBackoff = 1
+
+	failed := errors.New("failed")
+	var calls int
+	attempts, err := retryWithBackoff(context.Background(), func() error {
+		calls++
+		return failed
+	})
+	if err != failed || attempts != 3 || calls != 3 {
+		t.Errorf("attempts = %d, calls = %d, err = %v", attempts, calls, err)
+	}
+
+	calls = 0
+	attempts, err = retryWithBackoff(context.Background(), func() error {
+		if calls++; calls < 2 {
+			return failed
+		}
+		return nil
+	})
+	if err != nil || attempts != 2 {
+		t.Errorf("attempts = %d, err = %v", attempts, err)
+	}
+
+	config.Config.Kafka.DeadLetter.RetryBackoff = 60 * 1000
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	attempts, err = retryWithBackoff(ctx, func() error {
+		return failed
+	})
+	if err != failed || attempts != 1 || time.Since(start) > time.Second {
+		t.Errorf("attempts = %d, err = %v, canceled ctx waited %v", attempts, err, time.Since(start))
+	}
+}
@@ -23,16 +23,16 @@ import (
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
 
-	"github.com/Shopify/sarama"
 	"github.com/go-redis/redis"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
-	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/kafka"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mq"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/shutdown"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/rpcclient"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
@@ -53,7 +53,7 @@ type MsgChannelValue struct {
 
 type TriggerChannelValue struct {
 	ctx      context.Context
-	cMsgList []*sarama.ConsumerMessage
+	cMsgList []*mq.Message
 }
 
 type Cmd2Value struct {
@@ -66,9 +66,11 @@ type ContextMsg struct {
 }
 
 type OnlineHistoryRedisConsumerHandler struct {
-	historyConsumerGroup *kafka.MConsumerGroup
+	historyConsumerGroup mq.ConsumerGroup
 	chArrays             [ChannelNum]chan Cmd2Value
 	msgDistributionCh    chan Cmd2Value
+	// 已从kafka取出但还未处理完的批次, 退出时等待归零
+	pending sync.WaitGroup
 
 	singleMsgSuccessCount      uint64
 	singleMsgFailedCount       uint64
@@ -84,8 +86,16 @@ func NewOnlineHistoryRedisConsumerHandler(
 	database controller.CommonMsgDatabase,
 	conversationRpcClient *rpcclient.ConversationRpcClient,
 	groupRpcClient *rpcclient.GroupRpcClient,
-) *OnlineHistoryRedisConsumerHandler {
+) (*OnlineHistoryRedisConsumerHandler, error) {
+	consumerGroup, err := mq.NewConsumerGroup(
+		[]string{config.Config.Kafka.LatestMsgToRedis.Topic},
+		config.Config.Kafka.ConsumerGroupID.MsgToRedis,
+	)
+	if err != nil {
+		return nil, err
+	}
 	var och OnlineHistoryRedisConsumerHandler
+	och.historyConsumerGroup = consumerGroup
 	och.msgDatabase = database
 	och.msgDistributionCh = make(chan Cmd2Value) // no buffer channel
 	go och.MessagesDistributionHandle()
@@ -95,14 +105,9 @@ func NewOnlineHistoryRedisConsumerHandler(
 	}
 	och.conversationRpcClient = conversationRpcClient
 	och.groupRpcClient = groupRpcClient
-	och.historyConsumerGroup = kafka.NewMConsumerGroup(&kafka.MConsumerGroupConfig{
-		KafkaVersion:   sarama.V2_0_0_0,
-		OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false,
-	}, []string{config.Config.Kafka.LatestMsgToRedis.Topic},
-		config.Config.Kafka.Addr, config.Config.Kafka.ConsumerGroupID.MsgToRedis)
 	// statistics.NewStatistics(&och.singleMsgSuccessCount, config.Config.ModuleName.MsgTransferName, fmt.Sprintf("%d
 	// second singleMsgCount insert to mongo", constant.StatisticsTimeInterval), constant.StatisticsTimeInterval)
-	return &och
+	return &och, nil
 }
 
 func (och *OnlineHistoryRedisConsumerHandler) Run(channelID int) {
@@ -162,6 +167,7 @@ func (och *OnlineHistoryRedisConsumerHandler) Run(channelID int) {
 						modifyMsgList,
 					)
 				}
+				och.pending.Done()
 			}
 		}
 	}
@@ -235,7 +241,14 @@ func (och *OnlineHistoryRedisConsumerHandler) handleNotification(
 ) {
 	och.toPushTopic(ctx, key, conversationID, notStorageList)
 	if len(storageList) > 0 {
-		lastSeq, _, err := och.msgDatabase.BatchInsertChat2Cache(ctx, conversationID, storageList)
+		var (
+			lastSeq  int64
+			inserted []*sdkws.MsgData
+		)
+		attempts, err := retryWithBackoff(ctx, func() (err error) {
+			lastSeq, _, inserted, err = och.msgDatabase.BatchInsertChat2Cache(ctx, conversationID, storageList)
+			return err
+		})
 		if err != nil {
 			log.ZError(
 				ctx,
@@ -246,6 +259,11 @@ func (och *OnlineHistoryRedisConsumerHandler) handleNotification(
 				"storageList",
 				storageList,
 			)
+			toDeadLetter(ctx, och.msgDatabase, constant.DeadLetterStageMsgToRedis, key, conversationID, storageList, 0, attempts, err)
+			return
+		}
+		// 重复投递的消息已经写入过, 不再发往下游
+		if storageList = inserted; len(storageList) == 0 {
 			return
 		}
 		log.ZDebug(ctx, "success to next topic", "conversationID", conversationID)
@@ -271,12 +289,27 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(
 ) {
 	och.toPushTopic(ctx, key, conversationID, notStorageList)
 	if len(storageList) > 0 {
-		lastSeq, isNewConversation, err := och.msgDatabase.BatchInsertChat2Cache(ctx, conversationID, storageList)
-		if err != nil && errs.Unwrap(err) != redis.Nil {
+		var (
+			lastSeq           int64
+			isNewConversation bool
+			inserted          []*sdkws.MsgData
+		)
+		attempts, err := retryWithBackoff(ctx, func() (err error) {
+			lastSeq, isNewConversation, inserted, err = och.msgDatabase.BatchInsertChat2Cache(ctx, conversationID, storageList)
+			if errs.Unwrap(err) == redis.Nil {
+				return nil
+			}
+			return err
+		})
+		if err != nil {
 			log.ZError(ctx, "batch data insert to redis err", err, "storageMsgList", storageList)
 			och.singleMsgFailedCountMutex.Lock()
 			och.singleMsgFailedCount += uint64(len(storageList))
 			och.singleMsgFailedCountMutex.Unlock()
+			toDeadLetter(ctx, och.msgDatabase, constant.DeadLetterStageMsgToRedis, key, conversationID, storageList, 0, attempts, err)
+			return
+		}
+		if storageList = inserted; len(storageList) == 0 {
 			return
 		}
 		if isNewConversation {
@@ -338,7 +371,7 @@ func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 						"header",
 						strings.Join(arr, ", "),
 					)
-					ctxMsg.ctx = kafka.GetContextWithMQHeader(consumerMessages[i].Headers)
+					ctxMsg.ctx = mq.GetContextWithMQHeader(consumerMessages[i].Headers)
 					ctxMsg.message = msgFromMQ
 					log.ZDebug(
 						ctx,
@@ -360,6 +393,7 @@ func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 					}
 				}
 				log.ZDebug(ctx, "generate map list users len", "length", len(aggregationMsgs))
+				och.pending.Add(len(aggregationMsgs))
 				for uniqueKey, v := range aggregationMsgs {
 					if len(v) >= 0 {
 						hashCode := utils.GetHashCode(uniqueKey)
@@ -378,6 +412,7 @@ func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 						och.chArrays[channelID] <- Cmd2Value{Cmd: SourceMessages, Value: MsgChannelValue{uniqueKey: uniqueKey, ctxMsgList: v, ctx: newCtx}}
 					}
 				}
+				och.pending.Done()
 			}
 		}
 	}
@@ -397,14 +432,14 @@ func withAggregationCtx(ctx context.Context, values []*ContextMsg) context.Conte
 	return mcontext.SetOperationID(ctx, allMessageOperationID)
 }
 
-func (och *OnlineHistoryRedisConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error { return nil }
-func (och *OnlineHistoryRedisConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error {
+func (och *OnlineHistoryRedisConsumerHandler) Setup(_ mq.ConsumerGroupSession) error { return nil }
+func (och *OnlineHistoryRedisConsumerHandler) Cleanup(_ mq.ConsumerGroupSession) error {
 	return nil
 }
 
 func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(
-	sess sarama.ConsumerGroupSession,
-	claim sarama.ConsumerGroupClaim,
+	sess mq.ConsumerGroupSession,
+	claim mq.ConsumerGroupClaim,
 ) error { // a instance in the consumer group
 	for {
 		if sess == nil {
@@ -417,36 +452,44 @@ func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(
 	rwLock := new(sync.RWMutex)
 	log.ZDebug(context.Background(), "online new session msg come", "highWaterMarkOffset",
 		claim.HighWaterMarkOffset(), "topic", claim.Topic(), "partition", claim.Partition())
-	cMsg := make([]*sarama.ConsumerMessage, 0, 1000)
+	cMsg := make([]*mq.Message, 0, 1000)
 	t := time.NewTicker(time.Millisecond * 100)
+	flush := func() {
+		rwLock.Lock()
+		ccMsg := cMsg
+		cMsg = make([]*mq.Message, 0, 1000)
+		rwLock.Unlock()
+		if len(ccMsg) == 0 {
+			return
+		}
+		split := 1000
+		ctx := mcontext.WithTriggerIDContext(context.Background(), utils.OperationIDGenerator())
+		log.ZDebug(ctx, "timer trigger msg consumer start", "length", len(ccMsg))
+		for i := 0; i < len(ccMsg); i += split {
+			end := i + split
+			if end > len(ccMsg) {
+				end = len(ccMsg)
+			}
+			och.pending.Add(1)
+			och.msgDistributionCh <- Cmd2Value{Cmd: ConsumerMsgs, Value: TriggerChannelValue{
+				ctx: ctx, cMsgList: ccMsg[i:end],
+			}}
+		}
+		log.ZDebug(ctx, "timer trigger msg consumer end", "length", len(ccMsg))
+	}
+	done := make(chan struct{})
+	flushed := make(chan struct{})
 	go func() {
+		defer close(flushed)
+		defer t.Stop()
 		for {
 			select {
 			case <-t.C:
-				if len(cMsg) > 0 {
-					rwLock.Lock()
-					ccMsg := make([]*sarama.ConsumerMessage, 0, 1000)
-					for _, v := range cMsg {
-						ccMsg = append(ccMsg, v)
-					}
-					cMsg = make([]*sarama.ConsumerMessage, 0, 1000)
-					rwLock.Unlock()
-					split := 1000
-					ctx := mcontext.WithTriggerIDContext(context.Background(), utils.OperationIDGenerator())
-					log.ZDebug(ctx, "timer trigger msg consumer start", "length", len(ccMsg))
-					for i := 0; i < len(ccMsg)/split; i++ {
-						// log.Debug()
-						och.msgDistributionCh <- Cmd2Value{Cmd: ConsumerMsgs, Value: TriggerChannelValue{
-							ctx: ctx, cMsgList: ccMsg[i*split : (i+1)*split],
-						}}
-					}
-					if (len(ccMsg) % split) > 0 {
-						och.msgDistributionCh <- Cmd2Value{Cmd: ConsumerMsgs, Value: TriggerChannelValue{
-							ctx: ctx, cMsgList: ccMsg[split*(len(ccMsg)/split):],
-						}}
-					}
-					log.ZDebug(ctx, "timer trigger msg consumer end", "length", len(ccMsg))
-				}
+				flush()
+			case <-done:
+				// 会话结束(rebalance或退出)时发出剩余消息, 这些消息的offset已经标记
+				flush()
+				return
 			}
 		}
 	}()
@@ -458,5 +501,14 @@ func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(
 		rwLock.Unlock()
 		sess.MarkMessage(msg, "")
 	}
+	close(done)
+	<-flushed
 	return nil
 }
+
+// waitPending 等待已从kafka取出的消息全部处理完成, 最多等待退出超时时间.
+func (och *OnlineHistoryRedisConsumerHandler) waitPending() {
+	if !shutdown.WaitFunc(och.pending.Wait, shutdown.DrainTimeout()) {
+		log.ZWarn(context.Background(), "history consumer pending messages not finished before drain timeout", nil)
+	}
+}
@@ -17,38 +17,41 @@ package msgtransfer
 import (
 	"context"
 
-	"github.com/Shopify/sarama"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
-	kfk "github.com/OpenIMSDK/Open-IM-Server/pkg/common/kafka"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mq"
 	pbMsg "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
 )
 
 type OnlineHistoryMongoConsumerHandler struct {
-	historyConsumerGroup *kfk.MConsumerGroup
+	historyConsumerGroup mq.ConsumerGroup
 	msgDatabase          controller.CommonMsgDatabase
 }
 
-func NewOnlineHistoryMongoConsumerHandler(database controller.CommonMsgDatabase) *OnlineHistoryMongoConsumerHandler {
+func NewOnlineHistoryMongoConsumerHandler(database controller.CommonMsgDatabase) (*OnlineHistoryMongoConsumerHandler, error) {
+	consumerGroup, err := mq.NewConsumerGroup(
+		[]string{config.Config.Kafka.MsgToMongo.Topic},
+		config.Config.Kafka.ConsumerGroupID.MsgToMongo,
+	)
+	if err != nil {
+		return nil, err
+	}
 	mc := &OnlineHistoryMongoConsumerHandler{
-		historyConsumerGroup: kfk.NewMConsumerGroup(&kfk.MConsumerGroupConfig{
-			KafkaVersion:   sarama.V2_0_0_0,
-			OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false,
-		}, []string{config.Config.Kafka.MsgToMongo.Topic},
-			config.Config.Kafka.Addr, config.Config.Kafka.ConsumerGroupID.MsgToMongo),
-		msgDatabase: database,
+		historyConsumerGroup: consumerGroup,
+		msgDatabase:          database,
 	}
-	return mc
+	return mc, nil
 }
 
 func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(
 	ctx context.Context,
-	cMsg *sarama.ConsumerMessage,
+	cMsg *mq.Message,
 	key string,
-	session sarama.ConsumerGroupSession,
+	session mq.ConsumerGroupSession,
 ) {
 	msg := cMsg.Value
 	msgFromMQ := pbMsg.MsgDataToMongoByMQ{}
@@ -62,7 +65,9 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(
 		return
 	}
 	log.ZInfo(ctx, "mongo consumer recv msg", "msgs", msgFromMQ.MsgData)
-	err = mc.msgDatabase.BatchInsertChat2DB(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData, msgFromMQ.LastSeq)
+	attempts, err := retryWithBackoff(ctx, func() error {
+		return mc.msgDatabase.BatchInsertChat2DB(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData, msgFromMQ.LastSeq)
+	})
 	if err != nil {
 		log.ZError(
 			ctx,
@@ -73,6 +78,13 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(
 			"conversationID",
 			msgFromMQ.ConversationID,
 		)
+		// 未落库的消息保留在redis缓存中, 重放成功后再删除
+		toDeadLetter(ctx, mc.msgDatabase, constant.DeadLetterStageMsgToMongo, key, msgFromMQ.ConversationID,
+			msgFromMQ.MsgData, msgFromMQ.LastSeq, attempts, err)
+		return
+	}
+	if err := mc.msgDatabase.SetMsgsPersisted(ctx, msgFromMQ.MsgData); err != nil {
+		log.ZWarn(ctx, "SetMsgsPersisted failed", err, "conversationID", msgFromMQ.ConversationID)
 	}
 	var seqs []int64
 	for _, msg := range msgFromMQ.MsgData {
@@ -93,12 +105,12 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(
 	mc.msgDatabase.DelUserDeleteMsgsList(ctx, msgFromMQ.ConversationID, seqs)
 }
 
-func (OnlineHistoryMongoConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
-func (OnlineHistoryMongoConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+func (OnlineHistoryMongoConsumerHandler) Setup(_ mq.ConsumerGroupSession) error   { return nil }
+func (OnlineHistoryMongoConsumerHandler) Cleanup(_ mq.ConsumerGroupSession) error { return nil }
 
 func (mc *OnlineHistoryMongoConsumerHandler) ConsumeClaim(
-	sess sarama.ConsumerGroupSession,
-	claim sarama.ConsumerGroupClaim,
+	sess mq.ConsumerGroupSession,
+	claim mq.ConsumerGroupClaim,
 ) error { // a instance in the consumer group
 	log.ZDebug(context.Background(), "online new session msg come", "highWaterMarkOffset",
 		claim.HighWaterMarkOffset(), "topic", claim.Topic(), "partition", claim.Partition())
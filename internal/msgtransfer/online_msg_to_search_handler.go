@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgtransfer
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/search"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mq"
+	pbMsg "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+)
+
+type OnlineHistorySearchConsumerHandler struct {
+	historyConsumerGroup mq.ConsumerGroup
+	msgDatabase          controller.CommonMsgDatabase
+	searchIndex          search.MessageSearchIndex
+}
+
+// NewOnlineHistorySearchConsumerHandler 同时订阅死信重放的topic, 重放的批次只写入索引.
+func NewOnlineHistorySearchConsumerHandler(
+	msgDatabase controller.CommonMsgDatabase,
+	searchIndex search.MessageSearchIndex,
+) (*OnlineHistorySearchConsumerHandler, error) {
+	consumerGroup, err := mq.NewConsumerGroup(
+		[]string{config.Config.Kafka.MsgToMongo.Topic, config.Config.Kafka.MsgToSearch.Topic},
+		config.Config.Kafka.ConsumerGroupID.MsgToSearch,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &OnlineHistorySearchConsumerHandler{
+		historyConsumerGroup: consumerGroup,
+		msgDatabase:          msgDatabase,
+		searchIndex:          searchIndex,
+	}, nil
+}
+
+func (sc *OnlineHistorySearchConsumerHandler) handleChatWs2Search(ctx context.Context, cMsg *mq.Message, key string) {
+	msgFromMQ := pbMsg.MsgDataToMongoByMQ{}
+	if err := proto.Unmarshal(cMsg.Value, &msgFromMQ); err != nil {
+		log.ZError(ctx, "unmarshall failed", err, "key", key, "len", len(cMsg.Value))
+		return
+	}
+	if len(msgFromMQ.MsgData) == 0 {
+		return
+	}
+	attempts, err := retryWithBackoff(ctx, func() error {
+		return sc.searchIndex.Index(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData)
+	})
+	if err != nil {
+		log.ZError(ctx, "index msgs failed", err, "conversationID", msgFromMQ.ConversationID, "len", len(msgFromMQ.MsgData))
+		// 检索不再回退到mongo, 未写入索引的批次进入死信等待重放
+		toDeadLetter(ctx, sc.msgDatabase, constant.DeadLetterStageMsgToSearch, key, msgFromMQ.ConversationID,
+			msgFromMQ.MsgData, msgFromMQ.LastSeq, attempts, err)
+	}
+}
+
+func (OnlineHistorySearchConsumerHandler) Setup(_ mq.ConsumerGroupSession) error   { return nil }
+func (OnlineHistorySearchConsumerHandler) Cleanup(_ mq.ConsumerGroupSession) error { return nil }
+
+func (sc *OnlineHistorySearchConsumerHandler) ConsumeClaim(
+	sess mq.ConsumerGroupSession,
+	claim mq.ConsumerGroupClaim,
+) error {
+	for msg := range claim.Messages() {
+		ctx := sc.historyConsumerGroup.GetContextFromMsg(msg)
+		if len(msg.Value) != 0 {
+			sc.handleChatWs2Search(ctx, msg, string(msg.Key))
+		}
+		sess.MarkMessage(msg, "")
+	}
+	return nil
+}
@@ -20,36 +20,38 @@ import (
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
-	kfk "github.com/OpenIMSDK/Open-IM-Server/pkg/common/kafka"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mq"
 	pbMsg "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
 
-	"github.com/Shopify/sarama"
 	"google.golang.org/protobuf/proto"
 )
 
 type PersistentConsumerHandler struct {
-	persistentConsumerGroup *kfk.MConsumerGroup
+	persistentConsumerGroup mq.ConsumerGroup
 	chatLogDatabase         controller.ChatLogDatabase
 }
 
-func NewPersistentConsumerHandler(database controller.ChatLogDatabase) *PersistentConsumerHandler {
-	return &PersistentConsumerHandler{
-		persistentConsumerGroup: kfk.NewMConsumerGroup(&kfk.MConsumerGroupConfig{
-			KafkaVersion:   sarama.V2_0_0_0,
-			OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false,
-		}, []string{config.Config.Kafka.LatestMsgToRedis.Topic},
-			config.Config.Kafka.Addr, config.Config.Kafka.ConsumerGroupID.MsgToMySql),
-		chatLogDatabase: database,
+func NewPersistentConsumerHandler(database controller.ChatLogDatabase) (*PersistentConsumerHandler, error) {
+	consumerGroup, err := mq.NewConsumerGroup(
+		[]string{config.Config.Kafka.LatestMsgToRedis.Topic},
+		config.Config.Kafka.ConsumerGroupID.MsgToMySql,
+	)
+	if err != nil {
+		return nil, err
 	}
+	return &PersistentConsumerHandler{
+		persistentConsumerGroup: consumerGroup,
+		chatLogDatabase:         database,
+	}, nil
 }
 
 func (pc *PersistentConsumerHandler) handleChatWs2Mysql(
 	ctx context.Context,
-	cMsg *sarama.ConsumerMessage,
+	cMsg *mq.Message,
 	msgKey string,
-	_ sarama.ConsumerGroupSession,
+	_ mq.ConsumerGroupSession,
 ) {
 	msg := cMsg.Value
 	var tag bool
@@ -86,12 +88,12 @@ func (pc *PersistentConsumerHandler) handleChatWs2Mysql(
 		}
 	}
 }
-func (PersistentConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
-func (PersistentConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+func (PersistentConsumerHandler) Setup(_ mq.ConsumerGroupSession) error   { return nil }
+func (PersistentConsumerHandler) Cleanup(_ mq.ConsumerGroupSession) error { return nil }
 
 func (pc *PersistentConsumerHandler) ConsumeClaim(
-	sess sarama.ConsumerGroupSession,
-	claim sarama.ConsumerGroupClaim,
+	sess mq.ConsumerGroupSession,
+	claim mq.ConsumerGroupClaim,
 ) error {
 	for msg := range claim.Messages() {
 		ctx := pc.persistentConsumerGroup.GetContextFromMsg(msg)
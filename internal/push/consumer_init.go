@@ -16,6 +16,7 @@ package push
 
 import (
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/prome"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/shutdown"
 )
 
 type Consumer struct {
@@ -23,10 +24,14 @@ type Consumer struct {
 	successCount uint64
 }
 
-func NewConsumer(pusher *Pusher) *Consumer {
-	return &Consumer{
-		pushCh: *NewConsumerHandler(pusher),
+func NewConsumer(pusher *Pusher) (*Consumer, error) {
+	pushCh, err := NewConsumerHandler(pusher)
+	if err != nil {
+		return nil, err
 	}
+	return &Consumer{
+		pushCh: *pushCh,
+	}, nil
 }
 
 func (c *Consumer) initPrometheus() {
@@ -37,5 +42,7 @@ func (c *Consumer) initPrometheus() {
 func (c *Consumer) Start() {
 	// statistics.NewStatistics(&c.successCount, config.Config.ModuleName.PushName, fmt.Sprintf("%d second push to
 	// msg_gateway count", constant.StatisticsTimeInterval), constant.StatisticsTimeInterval)
-	go c.pushCh.pushConsumerGroup.RegisterHandleAndConsumer(&c.pushCh)
+	shutdown.Go(func() {
+		c.pushCh.pushConsumerGroup.RegisterHandleAndConsumer(shutdown.Context(), &c.pushCh)
+	})
 }
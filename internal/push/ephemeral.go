@@ -0,0 +1,48 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msggateway"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+// PushEphemeral 只推送给在线的用户, 不记录投递状态, 也不离线推送.
+func (p *Pusher) PushEphemeral(ctx context.Context, event *sdkws.EphemeralEvent, userIDs []string) error {
+	conns, err := p.discov.GetConns(ctx, config.Config.RpcRegisterName.OpenImMessageGatewayName)
+	if err != nil {
+		return err
+	}
+	gatewayUserIDs, _, ok := p.routeUsers(ctx, conns, userIDs)
+	if !ok {
+		gatewayUserIDs = make(map[grpc.ClientConnInterface][]string, len(conns))
+		for _, v := range conns {
+			gatewayUserIDs[v] = userIDs
+		}
+	}
+	for v, ids := range gatewayUserIDs {
+		req := &msggateway.OnlinePushEphemeralReq{Event: event, PushToUserIDs: ids}
+		if _, err := msggateway.NewMsgGatewayClient(v).OnlinePushEphemeral(ctx, req); err != nil {
+			log.ZWarn(ctx, "OnlinePushEphemeral failed", err, "userIDs", ids)
+		}
+	}
+	return nil
+}
@@ -53,7 +53,7 @@ func (j *JPush) Push(ctx context.Context, userIDs []string, title, content strin
 	au.SetAlias(userIDs)
 	var no body.Notification
 	var extras body.Extras
-	if opts.Signal.ClientMsgID != "" {
+	if opts.Signal != nil && opts.Signal.ClientMsgID != "" {
 		extras.ClientMsgID = opts.Signal.ClientMsgID
 	}
 	no.IOSEnableMutableContent()
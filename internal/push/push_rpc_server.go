@@ -26,6 +26,7 @@ import (
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/localcache"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/discoveryregistry"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
 	pbPush "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/push"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/rpcclient"
 )
@@ -55,6 +56,10 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		&groupRpcClient,
 		&msgRpcClient,
 	)
+	consumer, err := NewConsumer(pusher)
+	if err != nil {
+		return err
+	}
 	var wg sync.WaitGroup
 	wg.Add(2)
 	go func() {
@@ -65,7 +70,6 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 	}()
 	go func() {
 		defer wg.Done()
-		consumer := NewConsumer(pusher)
 		consumer.initPrometheus()
 		consumer.Start()
 	}()
@@ -99,3 +103,13 @@ func (r *pushServer) DelUserPushToken(
 	}
 	return &pbPush.DelUserPushTokenResp{}, nil
 }
+
+func (r *pushServer) PushEphemeral(ctx context.Context, req *pbPush.PushEphemeralReq) (*pbPush.PushEphemeralResp, error) {
+	if req.Event == nil {
+		return nil, errs.ErrArgs.Wrap("event is nil")
+	}
+	if err := r.pusher.PushEphemeral(ctx, req.Event, req.UserIDs); err != nil {
+		return nil, err
+	}
+	return &pbPush.PushEphemeralResp{}, nil
+}
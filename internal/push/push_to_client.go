@@ -18,6 +18,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/OpenIMSDK/Open-IM-Server/internal/push/offlinepush"
 	"github.com/OpenIMSDK/Open-IM-Server/internal/push/offlinepush/fcm"
@@ -48,10 +53,15 @@ type Pusher struct {
 	conversationRpcClient  *rpcclient.ConversationRpcClient
 	groupRpcClient         *rpcclient.GroupRpcClient
 	successCount           int
+	aliveLock              sync.Mutex
+	aliveGateways          map[string]time.Time // gatewayAddr -> 最近一次确认心跳有效的时间
 }
 
 var errNoOfflinePusher = errors.New("no offlinePusher is configured")
 
+// gatewayAliveCacheTime 网关心跳检查结果的缓存时间, 推送时通常只需查询一次路由表.
+const gatewayAliveCacheTime = time.Second * 5
+
 func NewPusher(discov discoveryregistry.SvcDiscoveryRegistry, offlinePusher offlinepush.OfflinePusher, database controller.PushDatabase,
 	groupLocalCache *localcache.GroupLocalCache, conversationLocalCache *localcache.ConversationLocalCache,
 	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient, msgRpcClient *rpcclient.MessageRpcClient,
@@ -256,9 +266,18 @@ func (p *Pusher) GetConnsAndOnlinePush(ctx context.Context, msg *sdkws.MsgData,
 		return nil, err
 	}
 	// Online push message
-	for _, v := range conns {
+	gatewayUserIDs, offlineUserIDs, ok := p.routeUsers(ctx, conns, pushToUserIDs)
+	if !ok {
+		// 路由表不可用时退回广播给所有网关
+		gatewayUserIDs = make(map[grpc.ClientConnInterface][]string, len(conns))
+		for _, v := range conns {
+			gatewayUserIDs[v] = pushToUserIDs
+		}
+		offlineUserIDs = nil
+	}
+	for v, userIDs := range gatewayUserIDs {
 		msgClient := msggateway.NewMsgGatewayClient(v)
-		reply, err := msgClient.SuperGroupOnlineBatchPushOneMsg(ctx, &msggateway.OnlineBatchPushOneMsgReq{MsgData: msg, PushToUserIDs: pushToUserIDs})
+		reply, err := msgClient.SuperGroupOnlineBatchPushOneMsg(ctx, &msggateway.OnlineBatchPushOneMsgReq{MsgData: msg, PushToUserIDs: userIDs})
 		if err != nil {
 			continue
 		}
@@ -267,9 +286,112 @@ func (p *Pusher) GetConnsAndOnlinePush(ctx context.Context, msg *sdkws.MsgData,
 			wsResults = append(wsResults, reply.SinglePushResult...)
 		}
 	}
+	for _, userID := range offlineUserIDs {
+		wsResults = append(wsResults, &msggateway.SingleMsgToUserResults{UserID: userID})
+	}
+	var onlinePushUserIDs []string
+	for _, v := range wsResults {
+		if v.OnlinePush {
+			onlinePushUserIDs = append(onlinePushUserIDs, v.UserID)
+		}
+	}
+	p.setMsgDeliveryState(ctx, msg, onlinePushUserIDs, constant.MsgDeliveryPushedOnline)
 	return wsResults, nil
 }
 
+// routeUsers groups the users by the gateways holding their conns according to the routing table published by the gateways.
+// ok is false when the table can not be trusted, e.g. a gateway has no fresh heartbeat.
+func (p *Pusher) routeUsers(ctx context.Context, conns []grpc.ClientConnInterface, userIDs []string) (gatewayUserIDs map[grpc.ClientConnInterface][]string, offlineUserIDs []string, ok bool) {
+	addrConns := make(map[string]grpc.ClientConnInterface, len(conns))
+	for _, v := range conns {
+		cc, ok := v.(*grpc.ClientConn)
+		if !ok {
+			return nil, nil, false
+		}
+		addrConns[cc.Target()] = v
+	}
+	if !p.gatewaysAlive(ctx, utils.Keys(addrConns)) {
+		return nil, nil, false
+	}
+	routes, err := p.database.GetUsersOnlineRoutes(ctx, userIDs)
+	if err != nil {
+		log.ZWarn(ctx, "GetUsersOnlineRoutes failed", err)
+		return nil, nil, false
+	}
+	gatewayUserIDs = make(map[grpc.ClientConnInterface][]string)
+	for _, userID := range utils.Distinct(userIDs) {
+		var online bool
+		for addr := range routes[userID] {
+			// 已下线的网关留下的路由, 等待过期
+			conn, ok := addrConns[addr]
+			if !ok {
+				continue
+			}
+			gatewayUserIDs[conn] = append(gatewayUserIDs[conn], userID)
+			online = true
+		}
+		if !online {
+			offlineUserIDs = append(offlineUserIDs, userID)
+		}
+	}
+	return gatewayUserIDs, offlineUserIDs, true
+}
+
+// gatewaysAlive 检查网关都有有效的心跳, 缓存时间内确认过的网关不再查询redis.
+func (p *Pusher) gatewaysAlive(ctx context.Context, gatewayAddrs []string) bool {
+	now := time.Now()
+	var unchecked []string
+	p.aliveLock.Lock()
+	for _, addr := range gatewayAddrs {
+		if now.Sub(p.aliveGateways[addr]) > gatewayAliveCacheTime {
+			unchecked = append(unchecked, addr)
+		}
+	}
+	p.aliveLock.Unlock()
+	if len(unchecked) == 0 {
+		return true
+	}
+	aliveAddrs, err := p.database.GetAliveGateways(ctx, unchecked)
+	if err != nil {
+		log.ZWarn(ctx, "GetAliveGateways failed", err)
+		return false
+	}
+	p.aliveLock.Lock()
+	if p.aliveGateways == nil {
+		p.aliveGateways = make(map[string]time.Time)
+	}
+	for _, addr := range aliveAddrs {
+		p.aliveGateways[addr] = now
+	}
+	p.aliveLock.Unlock()
+	if len(aliveAddrs) != len(unchecked) {
+		log.ZDebug(ctx, "gateway routes stale", "gateways", unchecked, "alive", aliveAddrs)
+		return false
+	}
+	return true
+}
+
+// setMsgDeliveryState records the push result of the recipients, the sender is skipped.
+// Only msgs tracked by msg rpc are updated, msgs without the receipt option and large groups are skipped here without a redis call.
+func (p *Pusher) setMsgDeliveryState(ctx context.Context, msg *sdkws.MsgData, userIDs []string, state int32) {
+	if !utils.Options(msg.Options).IsDeliveryReceipt() || utils.IsNotificationByMsg(msg) ||
+		len(userIDs) > constant.MsgDeliveryMaxGroupMemberNum+1 {
+		return
+	}
+	var recvIDs []string
+	for _, userID := range utils.Distinct(userIDs) {
+		if userID != msg.SendID {
+			recvIDs = append(recvIDs, userID)
+		}
+	}
+	if len(recvIDs) == 0 {
+		return
+	}
+	if err := p.database.SetMsgDeliveryUsersState(ctx, msg.ServerMsgID, recvIDs, state); err != nil {
+		log.ZWarn(ctx, "SetMsgDeliveryUsersState failed", err, "serverMsgID", msg.ServerMsgID, "state", state)
+	}
+}
+
 func (p *Pusher) offlinePushMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData, offlinePushUserIDs []string) error {
 	title, content, opts, err := p.getOfflinePushInfos(conversationID, msg)
 	if err != nil {
@@ -281,21 +403,22 @@ func (p *Pusher) offlinePushMsg(ctx context.Context, conversationID string, msg
 		return err
 	}
 	prome.Inc(prome.MsgOfflinePushSuccessCounter)
+	p.setMsgDeliveryState(ctx, msg, offlinePushUserIDs, constant.MsgDeliveryPushedOffline)
 	return nil
 }
 
 func (p *Pusher) GetOfflinePushOpts(msg *sdkws.MsgData) (opts *offlinepush.Opts, err error) {
 	opts = &offlinepush.Opts{}
-	// if msg.ContentType > constant.SignalingNotificationBegin && msg.ContentType < constant.SignalingNotificationEnd {
-	// 	req := &sdkws.SignalReq{}
-	// 	if err := proto.Unmarshal(msg.Content, req); err != nil {
-	// 		return nil, utils.Wrap(err, "")
-	// 	}
-	// 	switch req.Payload.(type) {
-	// 	case *sdkws.SignalReq_Invite, *sdkws.SignalReq_InviteInGroup:
-	// 		opts.Signal = &offlinepush.Signal{ClientMsgID: msg.ClientMsgID}
-	// 	}
-	// }
+	if msg.ContentType > constant.SignalingNotificationBegin && msg.ContentType < constant.SignalingNotificationEnd {
+		req := &sdkws.SignalReq{}
+		if err := proto.Unmarshal(msg.Content, req); err != nil {
+			return nil, utils.Wrap(err, "")
+		}
+		switch req.Payload.(type) {
+		case *sdkws.SignalReq_Invite, *sdkws.SignalReq_InviteInGroup:
+			opts.Signal = &offlinepush.Signal{ClientMsgID: msg.ClientMsgID}
+		}
+	}
 	if msg.OfflinePushInfo != nil {
 		opts.IOSBadgeCount = msg.OfflinePushInfo.IOSBadgeCount
 		opts.IOSPushSound = msg.OfflinePushInfo.IOSPushSound
@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+)
+
+type routeDatabase struct {
+	controller.PushDatabase
+	alive       map[string]bool
+	routes      map[string]map[string][]int
+	aliveCalls  int
+	routesCalls int
+}
+
+func (d *routeDatabase) GetAliveGateways(ctx context.Context, gatewayAddrs []string) ([]string, error) {
+	d.aliveCalls++
+	var res []string
+	for _, addr := range gatewayAddrs {
+		if d.alive[addr] {
+			res = append(res, addr)
+		}
+	}
+	return res, nil
+}
+
+func (d *routeDatabase) GetUsersOnlineRoutes(ctx context.Context, userIDs []string) (map[string]map[string][]int, error) {
+	d.routesCalls++
+	return d.routes, nil
+}
+
+func dialGateway(t *testing.T, addr string) grpc.ClientConnInterface {
+	t.Helper()
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestRouteUsers(t *testing.T) {
+	gw1, gw2 := dialGateway(t, "gw1:10140"), dialGateway(t, "gw2:10140")
+	conns := []grpc.ClientConnInterface{gw1, gw2}
+	db := &routeDatabase{
+		alive: map[string]bool{"gw1:10140": true, "gw2:10140": true},
+		routes: map[string]map[string][]int{
+			"u1": {"gw1:10140": {1}},
+			"u2": {"gw1:10140": {1}, "gw2:10140": {5}},
+			// 已下线网关留下的路由
+			"u3": {"gw3:10140": {1}},
+		},
+	}
+	p := &Pusher{database: db}
+	ctx := mcontext.NewCtx("routeUsers")
+	gatewayUserIDs, offlineUserIDs, ok := p.routeUsers(ctx, conns, []string{"u1", "u2", "u3", "u4", "u1"})
+	if !ok {
+		t.Fatal("routes not trusted")
+	}
+	if len(gatewayUserIDs[gw1]) != 2 || len(gatewayUserIDs[gw2]) != 1 || gatewayUserIDs[gw2][0] != "u2" {
+		t.Fatalf("gateway users %v", gatewayUserIDs)
+	}
+	if len(offlineUserIDs) != 2 || offlineUserIDs[0] != "u3" || offlineUserIDs[1] != "u4" {
+		t.Fatalf("offline users %v", offlineUserIDs)
+	}
+	// 心跳检查结果在缓存时间内复用, 每次推送只查询一次路由表
+	if _, _, ok := p.routeUsers(ctx, conns, []string{"u1"}); !ok {
+		t.Fatal("routes not trusted")
+	}
+	if db.aliveCalls != 1 || db.routesCalls != 2 {
+		t.Fatalf("alive calls %d, routes calls %d", db.aliveCalls, db.routesCalls)
+	}
+
+	// 新加入的网关没有心跳时退回广播
+	gw3 := dialGateway(t, "gw3:10140")
+	if _, _, ok := p.routeUsers(ctx, append(conns, gw3), []string{"u1"}); ok {
+		t.Fatal("routes trusted without heartbeat of gw3")
+	}
+	if db.aliveCalls != 2 {
+		t.Fatalf("alive calls %d, want only gw3 checked again", db.aliveCalls)
+	}
+}
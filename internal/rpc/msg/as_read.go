@@ -19,8 +19,10 @@ import (
 
 	"github.com/redis/go-redis/v9"
 
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
@@ -160,6 +162,61 @@ func (m *msgServer) MarkConversationAsRead(
 	return &msg.MarkConversationAsReadResp{}, nil
 }
 
+// GetGroupMsgReadStatus derives who has read a group msg from the has read seq of each member,
+// members whose min seq is bigger than seq can not see the msg and are not counted, neither is the sender.
+func (m *msgServer) GetGroupMsgReadStatus(ctx context.Context, req *msg.GetGroupMsgReadStatusReq) (*msg.GetGroupMsgReadStatusResp, error) {
+	if !config.Config.GroupMessageHasReadReceiptEnable {
+		return nil, errs.ErrMessageHasReadDisable.Wrap()
+	}
+	if err := tokenverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	conversation, err := m.Conversation.GetConversation(ctx, req.UserID, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if conversation.ConversationType != constant.SuperGroupChatType {
+		return nil, errs.ErrArgs.Wrap("not a group conversation")
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	memberIDs, err := m.Group.GetGroupMemberIDs(ctx, conversation.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	minSeqs, err := m.MsgDatabase.GetConversationUserMinSeqs(ctx, req.ConversationID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	hasReadSeqs, err := m.MsgDatabase.GetConversationUsersHasReadSeqs(ctx, req.ConversationID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.GetGroupMsgReadStatusResp{}
+	for _, userID := range memberIDs {
+		if userID == msgs[0].SendID || minSeqs[userID] > req.Seq {
+			continue
+		}
+		if hasReadSeqs[userID] >= req.Seq {
+			resp.HasReadCount++
+			if !req.CountOnly {
+				resp.HasReadUserIDs = append(resp.HasReadUserIDs, userID)
+			}
+		} else {
+			resp.UnreadCount++
+			if !req.CountOnly {
+				resp.UnreadUserIDs = append(resp.UnreadUserIDs, userID)
+			}
+		}
+	}
+	return resp, nil
+}
+
 func (m *msgServer) sendMarkAsReadNotification(
 	ctx context.Context,
 	conversationID string,
@@ -25,6 +25,7 @@ import (
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
 	pbChat "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
 )
 
@@ -162,3 +163,97 @@ func callbackMsgModify(ctx context.Context, msg *pbChat.SendMsgReq) error {
 	log.ZDebug(ctx, "callbackMsgModify", "msg", msg.MsgData)
 	return nil
 }
+
+func callbackSetMessageReactionExtensions(ctx context.Context, req *pbChat.SetMessageReactionExtensionsReq, msg *sdkws.MsgData) error {
+	if !config.Config.Callback.CallbackSetMessageReactionExtensions.Enable {
+		return nil
+	}
+	cbReq := &cbapi.CallbackBeforeSetMessageReactionExtReq{
+		OperationID:           mcontext.GetOperationID(ctx),
+		CallbackCommand:       constant.CallbackBeforeSetMessageReactionExtensionCommand,
+		ConversationID:        req.ConversationID,
+		OpUserID:              req.UserID,
+		SessionType:           msg.SessionType,
+		ClientMsgID:           msg.ClientMsgID,
+		Seq:                   msg.Seq,
+		ReactionExtensionList: keyValueMap(req.ReactionExtensions),
+	}
+	resp := &cbapi.CallbackBeforeSetMessageReactionExtResp{}
+	if err := http.CallBackPostReturn(ctx, cbURL(), cbReq, resp, config.Config.Callback.CallbackSetMessageReactionExtensions); err != nil {
+		if err == errs.ErrCallbackContinue {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func callbackAddMessageReactionExtensions(ctx context.Context, req *pbChat.SetMessageReactionExtensionsReq, msg *sdkws.MsgData, kvs map[string]*sdkws.KeyValue) error {
+	if !config.Config.Callback.CallbackSetMessageReactionExtensions.Enable {
+		return nil
+	}
+	cbReq := &cbapi.CallbackAddMessageReactionExtReq{
+		OperationID:           mcontext.GetOperationID(ctx),
+		CallbackCommand:       constant.CallbackAddMessageListReactionExtensionsCommand,
+		ConversationID:        req.ConversationID,
+		OpUserID:              req.UserID,
+		SessionType:           msg.SessionType,
+		ClientMsgID:           msg.ClientMsgID,
+		Seq:                   msg.Seq,
+		ReactionExtensionList: kvs,
+	}
+	resp := &cbapi.CallbackAddMessageReactionExtResp{}
+	if err := http.CallBackPostReturn(ctx, cbURL(), cbReq, resp, config.Config.Callback.CallbackSetMessageReactionExtensions); err != nil {
+		if err == errs.ErrCallbackContinue {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func callbackDeleteMessageReactionExtensions(ctx context.Context, req *pbChat.DeleteMessageReactionExtensionsReq, msg *sdkws.MsgData) error {
+	if !config.Config.Callback.CallbackSetMessageReactionExtensions.Enable {
+		return nil
+	}
+	cbReq := &cbapi.CallbackDeleteMessageReactionExtReq{
+		OperationID:     mcontext.GetOperationID(ctx),
+		CallbackCommand: constant.CallbackBeforeDeleteMessageReactionExtensionsCommand,
+		ConversationID:  req.ConversationID,
+		OpUserID:        req.UserID,
+		SessionType:     msg.SessionType,
+		ClientMsgID:     msg.ClientMsgID,
+		Seq:             msg.Seq,
+		TypeKeys:        req.TypeKeys,
+	}
+	resp := &cbapi.CallbackDeleteMessageReactionExtResp{}
+	if err := http.CallBackPostReturn(ctx, cbURL(), cbReq, resp, config.Config.Callback.CallbackSetMessageReactionExtensions); err != nil {
+		if err == errs.ErrCallbackContinue {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func callbackGetMessageListReactionExtensions(ctx context.Context, req *pbChat.GetMessagesReactionExtensionsReq) error {
+	if !config.Config.Callback.CallbackSetMessageReactionExtensions.Enable {
+		return nil
+	}
+	cbReq := &cbapi.CallbackGetMessageListReactionExtReq{
+		OperationID:     mcontext.GetOperationID(ctx),
+		CallbackCommand: constant.CallbackGetMessageListReactionExtensionsCommand,
+		ConversationID:  req.ConversationID,
+		OpUserID:        req.UserID,
+		Seqs:            req.Seqs,
+		TypeKeys:        req.TypeKeys,
+	}
+	resp := &cbapi.CallbackGetMessageListReactionExtResp{}
+	if err := http.CallBackPostReturn(ctx, cbURL(), cbReq, resp, config.Config.Callback.CallbackSetMessageReactionExtensions); err != nil {
+		if err == errs.ErrCallbackContinue {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
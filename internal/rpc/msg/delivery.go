@@ -0,0 +1,145 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"sort"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	pbMsg "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+// needMsgDeliveryStatus 只跟踪开启了投递回执的单聊和普通群中用户发送的消息, 通知, 超级群和大群不跟踪.
+// groupInfo为发送校验时获取的群信息.
+func needMsgDeliveryStatus(msgData *sdkws.MsgData, groupInfo *sdkws.GroupInfo) bool {
+	if !isMsgDeliveryReceipt(msgData) {
+		return false
+	}
+	switch msgData.SessionType {
+	case constant.SingleChatType:
+		return true
+	case constant.GroupChatType, constant.SuperGroupChatType:
+		return groupInfo != nil && groupInfo.GroupType != constant.SuperGroup &&
+			groupInfo.MemberCount <= constant.MsgDeliveryMaxGroupMemberNum
+	default:
+		return false
+	}
+}
+
+func isMsgDeliveryReceipt(msgData *sdkws.MsgData) bool {
+	return msgData.ContentType >= constant.ContentTypeBegin && msgData.ContentType < constant.NotificationBegin &&
+		!utils.IsNotificationByMsg(msgData) && utils.Options(msgData.Options).IsDeliveryReceipt()
+}
+
+// initMsgDeliveryStatus marks the msg as accepted, a failure here must not fail the sending.
+// Later states are only recorded for msgs initialized here.
+func (m *msgServer) initMsgDeliveryStatus(ctx context.Context, msgData *sdkws.MsgData, groupInfo *sdkws.GroupInfo) {
+	if !needMsgDeliveryStatus(msgData, groupInfo) {
+		return
+	}
+	if err := m.MsgDatabase.InitMsgDeliveryStatus(ctx, msgData); err != nil {
+		log.ZWarn(ctx, "InitMsgDeliveryStatus failed", err, "serverMsgID", msgData.ServerMsgID)
+	}
+}
+
+func (m *msgServer) MsgDeliveryAck(ctx context.Context, req *pbMsg.MsgDeliveryAckReq) (*pbMsg.MsgDeliveryAckResp, error) {
+	if err := tokenverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	targets, err := m.MsgDatabase.GetMsgsDeliveryTargets(ctx, req.ServerMsgIDs)
+	if err != nil {
+		return nil, err
+	}
+	// 只记录接收者的确认, 群聊按群分别校验成员
+	var serverMsgIDs []string
+	members := make(map[string]bool)
+	for serverMsgID, target := range targets {
+		if target.SendID == req.UserID {
+			continue
+		}
+		if target.GroupID == "" {
+			if target.RecvID == req.UserID {
+				serverMsgIDs = append(serverMsgIDs, serverMsgID)
+			}
+			continue
+		}
+		isMember, ok := members[target.GroupID]
+		if !ok {
+			isMember, err = m.Group.InAnyGroup(ctx, req.UserID, []string{target.GroupID})
+			if err != nil {
+				return nil, err
+			}
+			members[target.GroupID] = isMember
+		}
+		if isMember {
+			serverMsgIDs = append(serverMsgIDs, serverMsgID)
+		}
+	}
+	if err := m.MsgDatabase.AckMsgsDelivered(ctx, req.UserID, serverMsgIDs); err != nil {
+		return nil, err
+	}
+	return &pbMsg.MsgDeliveryAckResp{}, nil
+}
+
+func (m *msgServer) GetMsgDeliveryStatus(ctx context.Context, req *pbMsg.GetMsgDeliveryStatusReq) (*pbMsg.GetMsgDeliveryStatusResp, error) {
+	sendID, states, err := m.MsgDatabase.GetMsgDeliveryStatus(ctx, req.ServerMsgID)
+	if err != nil {
+		return nil, err
+	}
+	// 仅发送者和管理员可以查看
+	if err := tokenverify.CheckAccessV3(ctx, sendID); err != nil {
+		return nil, err
+	}
+	resp := &pbMsg.GetMsgDeliveryStatusResp{SendID: sendID, States: toMsgDeliveryStates(states[""])}
+	msgLastState := lastMsgDeliveryState(resp.States)
+	for userID, userStates := range states {
+		if userID == "" {
+			continue
+		}
+		recipient := &pbMsg.RecipientDeliveryStatus{UserID: userID, States: toMsgDeliveryStates(userStates)}
+		recipient.LastState = lastMsgDeliveryState(recipient.States)
+		if recipient.LastState < msgLastState {
+			recipient.LastState = msgLastState
+		}
+		resp.Recipients = append(resp.Recipients, recipient)
+	}
+	sort.Slice(resp.Recipients, func(i, j int) bool {
+		return resp.Recipients[i].UserID < resp.Recipients[j].UserID
+	})
+	return resp, nil
+}
+
+func toMsgDeliveryStates(states map[int32]int64) []*pbMsg.MsgDeliveryState {
+	res := make([]*pbMsg.MsgDeliveryState, 0, len(states))
+	for state, stateTime := range states {
+		res = append(res, &pbMsg.MsgDeliveryState{State: state, Time: stateTime})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].State < res[j].State
+	})
+	return res
+}
+
+func lastMsgDeliveryState(states []*pbMsg.MsgDeliveryState) int32 {
+	if len(states) == 0 {
+		return 0
+	}
+	return states[len(states)-1].State
+}
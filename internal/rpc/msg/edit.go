@@ -0,0 +1,84 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+func (m *msgServer) EditMsg(ctx context.Context, req *msg.EditMsgReq) (*msg.EditMsgResp, error) {
+	if err := tokenverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	user, err := m.User.GetUserInfo(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, errs.ErrMsgAlreadyRevoke.Wrap("msg already revoke")
+	}
+	if msgs[0].ContentType >= constant.NotificationBegin {
+		return nil, errs.ErrArgs.Wrap("notification can not be edited")
+	}
+	if _, err := m.checkMsgOperatePermission(ctx, req.UserID, user.AppMangerLevel, msgs[0]); err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	err = m.MsgDatabase.EditMsg(ctx, req.ConversationID, req.Seq, req.Content, &unRelationTb.EditModel{
+		Content:  string(msgs[0].Content),
+		UserID:   req.UserID,
+		Nickname: user.Nickname,
+		Time:     now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "EditMsg", "conversationID", req.ConversationID, "seq", req.Seq, "clientMsgID", msgs[0].ClientMsgID)
+	tips := sdkws.EditMsgTips{
+		EditorUserID:   req.UserID,
+		ClientMsgID:    msgs[0].ClientMsgID,
+		EditTime:       now,
+		Seq:            req.Seq,
+		SessionType:    msgs[0].SessionType,
+		ConversationID: req.ConversationID,
+		Content:        req.Content,
+	}
+	var recvID string
+	if msgs[0].SessionType == constant.SuperGroupChatType {
+		recvID = msgs[0].GroupID
+	} else {
+		recvID = msgs[0].RecvID
+	}
+	if err := m.notificationSender.NotificationWithSesstionType(ctx, req.UserID, recvID, constant.MsgEditNotification, msgs[0].SessionType, &tips); err != nil {
+		return nil, err
+	}
+	return &msg.EditMsgResp{EditTime: now}, nil
+}
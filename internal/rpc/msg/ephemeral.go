@@ -0,0 +1,120 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+func (m *msgServer) SendEphemeral(ctx context.Context, req *msg.SendEphemeralReq) (*msg.SendEphemeralResp, error) {
+	event := req.Event
+	if event == nil {
+		return nil, errs.ErrArgs.Wrap("event is nil")
+	}
+	if err := tokenverify.CheckAccessV3(ctx, event.SendID); err != nil {
+		return nil, err
+	}
+	if event.EventType <= 0 {
+		return nil, errs.ErrArgs.Wrap("eventType invalid")
+	}
+	if utf8.RuneCountInString(event.Content) > constant.EphemeralContentMaxLength {
+		return nil, errs.ErrArgs.Wrap("content too long")
+	}
+	if err := m.checkEphemeralRate(ctx, event.SendID); err != nil {
+		return nil, err
+	}
+	userIDs, err := m.getEphemeralRecvIDs(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	// 与普通消息相同的黑名单, 好友, 群成员和禁言校验
+	verifyReq := &msg.SendMsgReq{MsgData: &sdkws.MsgData{
+		SendID:      event.SendID,
+		RecvID:      event.RecvID,
+		GroupID:     event.GroupID,
+		SessionType: event.SessionType,
+		ContentType: constant.Typing,
+	}}
+	if err := m.messageVerification(ctx, verifyReq); err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return &msg.SendEphemeralResp{}, nil
+	}
+	event.SendTime = utils.GetCurrentTimestampByMill()
+	if err := m.Push.PushEphemeral(ctx, event, userIDs); err != nil {
+		return nil, err
+	}
+	return &msg.SendEphemeralResp{}, nil
+}
+
+func (m *msgServer) checkEphemeralRate(ctx context.Context, userID string) error {
+	if config.Config.Ephemeral.MaxPerSecond <= 0 {
+		return nil
+	}
+	count, err := m.EphemeralDatabase.IncrSendCount(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if count > int64(config.Config.Ephemeral.MaxPerSecond) {
+		log.ZDebug(ctx, "ephemeral rate limited", "userID", userID, "count", count)
+		return errs.ErrRateLimitExceeded.Wrap("send ephemeral event too frequently")
+	}
+	return nil
+}
+
+// getEphemeralRecvIDs 返回会话中除发送者外的参与者.
+func (m *msgServer) getEphemeralRecvIDs(ctx context.Context, event *sdkws.EphemeralEvent) ([]string, error) {
+	switch event.SessionType {
+	case constant.SingleChatType:
+		if event.RecvID == "" {
+			return nil, errs.ErrArgs.Wrap("recvID is empty")
+		}
+		if event.RecvID == event.SendID {
+			return nil, nil
+		}
+		return []string{event.RecvID}, nil
+	case constant.SuperGroupChatType:
+		if event.GroupID == "" {
+			return nil, errs.ErrArgs.Wrap("groupID is empty")
+		}
+		memberIDs, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, event.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if !utils.IsContain(event.SendID, memberIDs) && !utils.IsContain(event.SendID, config.Config.Manager.UserID) {
+			return nil, errs.ErrNotInGroupYet.Wrap()
+		}
+		recvIDs := make([]string, 0, len(memberIDs))
+		for _, memberID := range memberIDs {
+			if memberID != event.SendID {
+				recvIDs = append(recvIDs, memberID)
+			}
+		}
+		return recvIDs, nil
+	default:
+		return nil, errs.ErrArgs.Wrap("sessionType not support")
+	}
+}
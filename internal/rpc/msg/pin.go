@@ -0,0 +1,159 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	pbConversation "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/conversation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+const maxPinnedMsgNum = 50
+
+func (m *msgServer) PinMsg(ctx context.Context, req *msg.PinMsgReq) (*msg.PinMsgResp, error) {
+	conversation, err := m.checkPinPermission(ctx, req.UserID, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, errs.ErrMsgAlreadyRevoke.Wrap("msg already revoke")
+	}
+	if msgs[0].ContentType >= constant.NotificationBegin {
+		return nil, errs.ErrArgs.Wrap("notification can not be pinned")
+	}
+	now := time.Now().UnixMilli()
+	pinnedMsg := &unRelationTb.PinnedMsgModel{
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		ClientMsgID:    msgs[0].ClientMsgID,
+		PinUserID:      req.UserID,
+		PinTime:        now,
+	}
+	if err := m.PinnedMsgDatabase.PinMsg(ctx, pinnedMsg, maxPinnedMsgNum); err != nil {
+		return nil, err
+	}
+	if err := m.notificationSender.MsgPinnedNotification(ctx, req.UserID, pinRecvID(conversation), req.ConversationID,
+		conversation.ConversationType, pinnedMsg.ClientMsgID, req.Seq, true, now); err != nil {
+		return nil, err
+	}
+	return &msg.PinMsgResp{PinTime: now}, nil
+}
+
+func (m *msgServer) UnpinMsg(ctx context.Context, req *msg.UnpinMsgReq) (*msg.UnpinMsgResp, error) {
+	conversation, err := m.checkPinPermission(ctx, req.UserID, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	pinnedMsgs, err := m.PinnedMsgDatabase.GetPinnedMsgs(ctx, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	var clientMsgID string
+	for _, pinnedMsg := range pinnedMsgs {
+		if pinnedMsg.Seq == req.Seq {
+			clientMsgID = pinnedMsg.ClientMsgID
+			break
+		}
+	}
+	if err := m.PinnedMsgDatabase.UnpinMsg(ctx, req.ConversationID, req.Seq); err != nil {
+		return nil, err
+	}
+	if err := m.notificationSender.MsgPinnedNotification(ctx, req.UserID, pinRecvID(conversation), req.ConversationID,
+		conversation.ConversationType, clientMsgID, req.Seq, false, time.Now().UnixMilli()); err != nil {
+		return nil, err
+	}
+	return &msg.UnpinMsgResp{}, nil
+}
+
+func (m *msgServer) GetPinnedMsgs(ctx context.Context, req *msg.GetPinnedMsgsReq) (*msg.GetPinnedMsgsResp, error) {
+	if err := m.checkConversationAccess(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	pinnedMsgs, err := m.PinnedMsgDatabase.GetPinnedMsgs(ctx, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.GetPinnedMsgsResp{}
+	if len(pinnedMsgs) == 0 {
+		return resp, nil
+	}
+	seqs := make([]int64, 0, len(pinnedMsgs))
+	for _, pinnedMsg := range pinnedMsgs {
+		seqs = append(seqs, pinnedMsg.Seq)
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	msgMap := make(map[int64]*sdkws.MsgData, len(msgs))
+	for _, msgData := range msgs {
+		if msgData != nil {
+			msgMap[msgData.Seq] = msgData
+		}
+	}
+	for _, pinnedMsg := range pinnedMsgs {
+		// msgData为空表示消息已被当前用户删除或已过期
+		resp.PinnedMsgs = append(resp.PinnedMsgs, &msg.PinnedMsg{
+			Seq:         pinnedMsg.Seq,
+			ClientMsgID: pinnedMsg.ClientMsgID,
+			PinUserID:   pinnedMsg.PinUserID,
+			PinTime:     pinnedMsg.PinTime,
+			MsgData:     msgMap[pinnedMsg.Seq],
+		})
+	}
+	return resp, nil
+}
+
+// checkPinPermission only allows group owners and admins to pin msgs of a group, both sides of a single chat may pin.
+func (m *msgServer) checkPinPermission(ctx context.Context, userID, conversationID string) (*pbConversation.Conversation, error) {
+	if err := tokenverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, err
+	}
+	conversation, err := m.Conversation.GetConversation(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	switch conversation.ConversationType {
+	case constant.SingleChatType:
+	case constant.SuperGroupChatType:
+		if err := m.Group.CheckGroupAdmin(ctx, conversation.GroupID, userID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errs.ErrArgs.Wrap("conversation type not supported")
+	}
+	return conversation, nil
+}
+
+func pinRecvID(conversation *pbConversation.Conversation) string {
+	if conversation.ConversationType == constant.SuperGroupChatType {
+		return conversation.GroupID
+	}
+	return conversation.UserID
+}
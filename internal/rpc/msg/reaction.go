@@ -0,0 +1,241 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/apiresp"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+func (m *msgServer) SetMessageReactionExtensions(
+	ctx context.Context,
+	req *msg.SetMessageReactionExtensionsReq,
+) (*msg.SetMessageReactionExtensionsResp, error) {
+	msgData, err := m.getReactionMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if err := callbackSetMessageReactionExtensions(ctx, req, msgData); err != nil {
+		return nil, err
+	}
+	resp := &msg.SetMessageReactionExtensionsResp{}
+	successList := make(map[string]*sdkws.KeyValue)
+	now := time.Now().UnixMilli()
+	for _, kv := range req.ReactionExtensions {
+		kv.LatestUpdateTime = now
+		err := m.setMessageReactionExtension(ctx, req.ConversationID, msgData, req.UserID, kv)
+		resp.Result = append(resp.Result, toKeyValueResp(kv, err))
+		if err != nil {
+			log.ZWarn(ctx, "setMessageReactionExtension failed", err, "conversationID", req.ConversationID, "seq", req.Seq, "typeKey", kv.TypeKey)
+			continue
+		}
+		successList[kv.TypeKey] = kv
+	}
+	if len(successList) == 0 {
+		return resp, nil
+	}
+	if err := callbackAddMessageReactionExtensions(ctx, req, msgData, successList); err != nil {
+		log.ZWarn(ctx, "callbackAddMessageReactionExtensions failed", err, "conversationID", req.ConversationID, "seq", req.Seq)
+	}
+	tips := sdkws.ReactionMessageModifierNotification{
+		ConversationID:            req.ConversationID,
+		OpUserID:                  req.UserID,
+		SessionType:               msgData.SessionType,
+		ClientMsgID:               msgData.ClientMsgID,
+		Seq:                       msgData.Seq,
+		SuccessReactionExtensions: successList,
+	}
+	if err := m.notificationSender.NotificationWithSesstionType(ctx, req.UserID, reactionRecvID(msgData, req.UserID), constant.ReactionMessageModifier, msgData.SessionType, &tips); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *msgServer) DeleteMessageReactionExtensions(
+	ctx context.Context,
+	req *msg.DeleteMessageReactionExtensionsReq,
+) (*msg.DeleteMessageReactionExtensionsResp, error) {
+	msgData, err := m.getReactionMsg(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if err := callbackDeleteMessageReactionExtensions(ctx, req, msgData); err != nil {
+		return nil, err
+	}
+	resp := &msg.DeleteMessageReactionExtensionsResp{}
+	successList := make(map[string]*sdkws.KeyValue)
+	now := time.Now().UnixMilli()
+	for _, typeKey := range req.TypeKeys {
+		kv := &sdkws.KeyValue{TypeKey: typeKey, LatestUpdateTime: now}
+		err := m.deleteMessageReactionExtension(ctx, req.ConversationID, msgData, typeKey)
+		resp.Result = append(resp.Result, toKeyValueResp(kv, err))
+		if err != nil {
+			log.ZWarn(ctx, "deleteMessageReactionExtension failed", err, "conversationID", req.ConversationID, "seq", req.Seq, "typeKey", typeKey)
+			continue
+		}
+		successList[typeKey] = kv
+	}
+	if len(successList) == 0 {
+		return resp, nil
+	}
+	tips := sdkws.ReactionMessageDeleteNotification{
+		ConversationID:            req.ConversationID,
+		OpUserID:                  req.UserID,
+		SessionType:               msgData.SessionType,
+		ClientMsgID:               msgData.ClientMsgID,
+		Seq:                       msgData.Seq,
+		SuccessReactionExtensions: successList,
+	}
+	if err := m.notificationSender.NotificationWithSesstionType(ctx, req.UserID, reactionRecvID(msgData, req.UserID), constant.ReactionMessageDeleter, msgData.SessionType, &tips); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m *msgServer) GetMessagesReactionExtensions(
+	ctx context.Context,
+	req *msg.GetMessagesReactionExtensionsReq,
+) (*msg.GetMessagesReactionExtensionsResp, error) {
+	if err := m.checkConversationAccess(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	if err := callbackGetMessageListReactionExtensions(ctx, req); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, req.Seqs)
+	if err != nil {
+		return nil, err
+	}
+	var reactionMsgs []*sdkws.MsgData
+	for _, msgData := range msgs {
+		if msgData == nil || msgData.ContentType >= constant.NotificationBegin {
+			continue
+		}
+		reactionMsgs = append(reactionMsgs, msgData)
+	}
+	reactions, err := m.MsgDatabase.GetMsgsReactionExtensions(ctx, req.ConversationID, reactionMsgs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.GetMessagesReactionExtensionsResp{}
+	for _, msgData := range reactionMsgs {
+		kvs := reactions[msgData.Seq]
+		if len(req.TypeKeys) > 0 {
+			filtered := make(map[string]*sdkws.KeyValue, len(req.TypeKeys))
+			for _, typeKey := range req.TypeKeys {
+				if kv, ok := kvs[typeKey]; ok {
+					filtered[typeKey] = kv
+				}
+			}
+			kvs = filtered
+		}
+		resp.SingleMessageResult = append(resp.SingleMessageResult, &msg.SingleMessageExtensionResult{
+			Seq:                msgData.Seq,
+			ClientMsgID:        msgData.ClientMsgID,
+			ReactionExtensions: kvs,
+		})
+	}
+	return resp, nil
+}
+
+func (m *msgServer) checkConversationAccess(ctx context.Context, userID, conversationID string) error {
+	if err := tokenverify.CheckAccessV3(ctx, userID); err != nil {
+		return err
+	}
+	_, err := m.Conversation.GetConversation(ctx, userID, conversationID)
+	return err
+}
+
+// getReactionMsg returns the message a user is going to react to.
+func (m *msgServer) getReactionMsg(ctx context.Context, userID, conversationID string, seq int64) (*sdkws.MsgData, error) {
+	if err := m.checkConversationAccess(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, errs.ErrMsgAlreadyRevoke.Wrap("msg already revoke")
+	}
+	if msgs[0].ContentType >= constant.NotificationBegin {
+		return nil, errs.ErrArgs.Wrap("notification can not be reacted")
+	}
+	return msgs[0], nil
+}
+
+// setMessageReactionExtension locks the type key so mongo and the reaction cache are updated in the same order.
+func (m *msgServer) setMessageReactionExtension(ctx context.Context, conversationID string, msgData *sdkws.MsgData, userID string, kv *sdkws.KeyValue) error {
+	if err := m.MessageLocker.LockMessageTypeKey(ctx, msgData.ClientMsgID, kv.TypeKey); err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.MessageLocker.UnLockMessageTypeKey(ctx, msgData.ClientMsgID, kv.TypeKey); err != nil {
+			log.ZWarn(ctx, "UnLockMessageTypeKey failed", err, "clientMsgID", msgData.ClientMsgID, "typeKey", kv.TypeKey)
+		}
+	}()
+	return m.MsgDatabase.SetMsgReactionExtension(ctx, conversationID, msgData, userID, kv)
+}
+
+func (m *msgServer) deleteMessageReactionExtension(ctx context.Context, conversationID string, msgData *sdkws.MsgData, typeKey string) error {
+	if err := m.MessageLocker.LockMessageTypeKey(ctx, msgData.ClientMsgID, typeKey); err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.MessageLocker.UnLockMessageTypeKey(ctx, msgData.ClientMsgID, typeKey); err != nil {
+			log.ZWarn(ctx, "UnLockMessageTypeKey failed", err, "clientMsgID", msgData.ClientMsgID, "typeKey", typeKey)
+		}
+	}()
+	return m.MsgDatabase.DeleteMsgReactionExtension(ctx, conversationID, msgData, typeKey)
+}
+
+func reactionRecvID(msgData *sdkws.MsgData, opUserID string) string {
+	if msgData.SessionType == constant.SuperGroupChatType {
+		return msgData.GroupID
+	}
+	if msgData.SendID == opUserID {
+		return msgData.RecvID
+	}
+	return msgData.SendID
+}
+
+func toKeyValueResp(kv *sdkws.KeyValue, err error) *msg.KeyValueResp {
+	resp := &msg.KeyValueResp{KeyValue: kv}
+	if err != nil {
+		errResp := apiresp.ParseError(err)
+		resp.ErrCode = int32(errResp.ErrCode)
+		resp.ErrMsg = errResp.ErrMsg
+	}
+	return resp
+}
+
+func keyValueMap(kvs []*sdkws.KeyValue) map[string]*sdkws.KeyValue {
+	m := make(map[string]*sdkws.KeyValue, len(kvs))
+	for _, kv := range kvs {
+		m[kv.TypeKey] = kv
+	}
+	return m
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+)
+
+// SetRetentionPolicy creates or replaces the retention policy of a group or a user, the cron task enforces it.
+func (m *msgServer) SetRetentionPolicy(ctx context.Context, req *msg.SetRetentionPolicyReq) (*msg.SetRetentionPolicyResp, error) {
+	if err := tokenverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	switch req.TargetType {
+	case constant.RetentionTargetGroup:
+		if _, err := m.Group.GetGroupInfo(ctx, req.TargetID); err != nil {
+			return nil, err
+		}
+	case constant.RetentionTargetUser:
+		if _, err := m.User.GetUserInfo(ctx, req.TargetID); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	policy := &unRelationTb.RetentionPolicyModel{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		MaxAge:     req.MaxAge,
+		MaxMsgNum:  req.MaxMsgNum,
+		LegalHold:  req.LegalHold,
+		OpUserID:   mcontext.GetOpUserID(ctx),
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	if err := m.RetentionPolicyDatabase.SetRetentionPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return &msg.SetRetentionPolicyResp{}, nil
+}
+
+func (m *msgServer) DeleteRetentionPolicy(ctx context.Context, req *msg.DeleteRetentionPolicyReq) (*msg.DeleteRetentionPolicyResp, error) {
+	if err := tokenverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.RetentionPolicyDatabase.DeleteRetentionPolicy(ctx, req.TargetType, req.TargetID); err != nil {
+		return nil, err
+	}
+	return &msg.DeleteRetentionPolicyResp{}, nil
+}
+
+func (m *msgServer) GetRetentionPolicies(ctx context.Context, req *msg.GetRetentionPoliciesReq) (*msg.GetRetentionPoliciesResp, error) {
+	if err := tokenverify.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, policies, err := m.RetentionPolicyDatabase.PageRetentionPolicies(ctx, req.TargetType, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.GetRetentionPoliciesResp{Total: total}
+	for _, policy := range policies {
+		resp.Policies = append(resp.Policies, &msg.RetentionPolicy{
+			TargetType: policy.TargetType,
+			TargetID:   policy.TargetID,
+			MaxAge:     policy.MaxAge,
+			MaxMsgNum:  policy.MaxMsgNum,
+			LegalHold:  policy.LegalHold,
+			OpUserID:   policy.OpUserID,
+			CreateTime: policy.CreateTime.UnixMilli(),
+			UpdateTime: policy.UpdateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
@@ -59,41 +59,9 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 	}
 	data, _ := json.Marshal(msgs[0])
 	log.ZInfo(ctx, "GetMsgBySeqs", "conversationID", req.ConversationID, "seq", req.Seq, "msg", string(data))
-	var role int32
-	if !tokenverify.IsAppManagerUid(ctx) {
-		switch msgs[0].SessionType {
-		case constant.SingleChatType:
-			if err := tokenverify.CheckAccessV3(ctx, msgs[0].SendID); err != nil {
-				return nil, err
-			}
-			role = user.AppMangerLevel
-		case constant.SuperGroupChatType:
-			members, err := m.Group.GetGroupMemberInfoMap(
-				ctx,
-				msgs[0].GroupID,
-				utils.Distinct([]string{req.UserID, msgs[0].SendID}),
-				true,
-			)
-			if err != nil {
-				return nil, err
-			}
-			if req.UserID != msgs[0].SendID {
-				switch members[req.UserID].RoleLevel {
-				case constant.GroupOwner:
-				case constant.GroupAdmin:
-					if members[msgs[0].SendID].RoleLevel != constant.GroupOrdinaryUsers {
-						return nil, errs.ErrNoPermission.Wrap("no permission")
-					}
-				default:
-					return nil, errs.ErrNoPermission.Wrap("no permission")
-				}
-			}
-			if member := members[req.UserID]; member != nil {
-				role = member.RoleLevel
-			}
-		default:
-			return nil, errs.ErrInternalServer.Wrap("msg sessionType not supported")
-		}
+	role, err := m.checkMsgOperatePermission(ctx, req.UserID, user.AppMangerLevel, msgs[0])
+	if err != nil {
+		return nil, err
 	}
 	now := time.Now().UnixMilli()
 	err = m.MsgDatabase.RevokeMsg(ctx, req.ConversationID, req.Seq, &unRelationTb.RevokeModel{
@@ -124,3 +92,45 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 	}
 	return &msg.RevokeMsgResp{}, nil
 }
+
+// checkMsgOperatePermission checks whether userID may revoke or edit msgData and returns the operator's role,
+// senders can operate their own messages, group owners any message and group admins those of ordinary members.
+func (m *msgServer) checkMsgOperatePermission(ctx context.Context, userID string, appManagerLevel int32, msgData *sdkws.MsgData) (int32, error) {
+	if tokenverify.IsAppManagerUid(ctx) {
+		return 0, nil
+	}
+	switch msgData.SessionType {
+	case constant.SingleChatType:
+		if err := tokenverify.CheckAccessV3(ctx, msgData.SendID); err != nil {
+			return 0, err
+		}
+		return appManagerLevel, nil
+	case constant.SuperGroupChatType:
+		members, err := m.Group.GetGroupMemberInfoMap(
+			ctx,
+			msgData.GroupID,
+			utils.Distinct([]string{userID, msgData.SendID}),
+			true,
+		)
+		if err != nil {
+			return 0, err
+		}
+		if userID != msgData.SendID {
+			switch members[userID].RoleLevel {
+			case constant.GroupOwner:
+			case constant.GroupAdmin:
+				if members[msgData.SendID].RoleLevel != constant.GroupOrdinaryUsers {
+					return 0, errs.ErrNoPermission.Wrap("no permission")
+				}
+			default:
+				return 0, errs.ErrNoPermission.Wrap("no permission")
+			}
+		}
+		if member := members[userID]; member != nil {
+			return member.RoleLevel, nil
+		}
+		return 0, nil
+	default:
+		return 0, errs.ErrInternalServer.Wrap("msg sessionType not supported")
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+// ScheduleMsg stores a msg which will be sent by the cron task through SendMsg once its sendTime is reached.
+func (m *msgServer) ScheduleMsg(ctx context.Context, req *msg.ScheduleMsgReq) (*msg.ScheduleMsgResp, error) {
+	if err := tokenverify.CheckAccessV3(ctx, req.MsgData.SendID); err != nil {
+		return nil, err
+	}
+	if req.SendTime <= time.Now().UnixMilli() {
+		return nil, errs.ErrArgs.Wrap("sendTime must be in the future")
+	}
+	switch req.MsgData.SessionType {
+	case constant.SingleChatType, constant.NotificationChatType, constant.SuperGroupChatType:
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown sessionType")
+	}
+	// 提前校验一次, 发送时仍会走完整的SendMsg校验和回调
+	if err := m.messageVerification(ctx, &msg.SendMsgReq{MsgData: req.MsgData}); err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(req.MsgData)
+	if err != nil {
+		return nil, errs.ErrArgs.Wrap(err.Error())
+	}
+	now := time.Now()
+	scheduledMsg := &unRelationTb.ScheduledMsgModel{
+		ScheduledMsgID: GetMsgID(req.MsgData.SendID),
+		SendID:         req.MsgData.SendID,
+		SendTime:       req.SendTime,
+		Status:         constant.ScheduledMsgPending,
+		MsgData:        data,
+		CreateTime:     now,
+		UpdateTime:     now,
+	}
+	if err := m.ScheduledMsgDatabase.CreateScheduledMsg(ctx, scheduledMsg); err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "ScheduleMsg", "scheduledMsgID", scheduledMsg.ScheduledMsgID, "sendTime", req.SendTime)
+	return &msg.ScheduleMsgResp{ScheduledMsgID: scheduledMsg.ScheduledMsgID, SendTime: req.SendTime}, nil
+}
+
+func (m *msgServer) GetScheduledMsgs(ctx context.Context, req *msg.GetScheduledMsgsReq) (*msg.GetScheduledMsgsResp, error) {
+	if err := tokenverify.CheckAccessV3(ctx, req.SendID); err != nil {
+		return nil, err
+	}
+	total, scheduledMsgs, err := m.ScheduledMsgDatabase.PageScheduledMsgs(ctx, req.SendID, req.Status, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.GetScheduledMsgsResp{Total: total}
+	for _, scheduledMsg := range scheduledMsgs {
+		var msgData sdkws.MsgData
+		if err := proto.Unmarshal(scheduledMsg.MsgData, &msgData); err != nil {
+			return nil, errs.ErrData.Wrap(err.Error())
+		}
+		resp.ScheduledMsgs = append(resp.ScheduledMsgs, &msg.ScheduledMsg{
+			ScheduledMsgID: scheduledMsg.ScheduledMsgID,
+			SendTime:       scheduledMsg.SendTime,
+			Status:         scheduledMsg.Status,
+			MsgData:        &msgData,
+			ServerMsgID:    scheduledMsg.ServerMsgID,
+			ErrMsg:         scheduledMsg.ErrMsg,
+			CreateTime:     scheduledMsg.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+func (m *msgServer) SetScheduledMsg(ctx context.Context, req *msg.SetScheduledMsgReq) (*msg.SetScheduledMsgResp, error) {
+	scheduledMsg, err := m.takeSenderScheduledMsg(ctx, req.SendID, req.ScheduledMsgID)
+	if err != nil {
+		return nil, err
+	}
+	update := make(map[string]any)
+	if req.SendTime != nil {
+		if req.SendTime.Value <= time.Now().UnixMilli() {
+			return nil, errs.ErrArgs.Wrap("sendTime must be in the future")
+		}
+		update["send_time"] = req.SendTime.Value
+	}
+	if req.Content != nil {
+		var msgData sdkws.MsgData
+		if err := proto.Unmarshal(scheduledMsg.MsgData, &msgData); err != nil {
+			return nil, errs.ErrData.Wrap(err.Error())
+		}
+		msgData.Content = []byte(req.Content.Value)
+		data, err := proto.Marshal(&msgData)
+		if err != nil {
+			return nil, errs.ErrArgs.Wrap(err.Error())
+		}
+		update["msg_data"] = data
+	}
+	if err := m.ScheduledMsgDatabase.UpdatePendingScheduledMsg(ctx, req.ScheduledMsgID, update); err != nil {
+		return nil, err
+	}
+	return &msg.SetScheduledMsgResp{}, nil
+}
+
+func (m *msgServer) CancelScheduledMsg(ctx context.Context, req *msg.CancelScheduledMsgReq) (*msg.CancelScheduledMsgResp, error) {
+	if _, err := m.takeSenderScheduledMsg(ctx, req.SendID, req.ScheduledMsgID); err != nil {
+		return nil, err
+	}
+	if err := m.ScheduledMsgDatabase.CancelScheduledMsg(ctx, req.ScheduledMsgID); err != nil {
+		return nil, err
+	}
+	return &msg.CancelScheduledMsgResp{}, nil
+}
+
+func (m *msgServer) takeSenderScheduledMsg(ctx context.Context, sendID, scheduledMsgID string) (*unRelationTb.ScheduledMsgModel, error) {
+	if err := tokenverify.CheckAccessV3(ctx, sendID); err != nil {
+		return nil, err
+	}
+	scheduledMsg, err := m.ScheduledMsgDatabase.TakeScheduledMsg(ctx, scheduledMsgID)
+	if err != nil {
+		return nil, err
+	}
+	if scheduledMsg.SendID != sendID {
+		return nil, errs.ErrNoPermission.Wrap("not the sender of the scheduled msg")
+	}
+	return scheduledMsg, nil
+}
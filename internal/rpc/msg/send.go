@@ -28,7 +28,7 @@ import (
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
 )
 
-func (m *msgServer) SendMsg(ctx context.Context, req *pbMsg.SendMsgReq) (resp *pbMsg.SendMsgResp, error error) {
+func (m *msgServer) SendMsg(ctx context.Context, req *pbMsg.SendMsgReq) (resp *pbMsg.SendMsgResp, err error) {
 	resp = &pbMsg.SendMsgResp{}
 	if req.MsgData != nil {
 		flag := isMessageHasReadEnabled(req.MsgData)
@@ -36,6 +36,32 @@ func (m *msgServer) SendMsg(ctx context.Context, req *pbMsg.SendMsgReq) (resp *p
 			return nil, errs.ErrMessageHasReadDisable.Wrap()
 		}
 		m.encapsulateMsgData(req.MsgData)
+		// 客户端超时重发时返回首次发送的结果, 不再生成新消息
+		record, claimErr := m.MsgDatabase.ClaimClientMsgID(ctx, req.MsgData)
+		if claimErr != nil {
+			return nil, claimErr
+		}
+		if record != nil {
+			log.ZInfo(ctx, "duplicate msg", "clientMsgID", req.MsgData.ClientMsgID, "serverMsgID", record.ServerMsgID, "seq", record.Seq)
+			return &pbMsg.SendMsgResp{
+				ServerMsgID: record.ServerMsgID,
+				ClientMsgID: req.MsgData.ClientMsgID,
+				SendTime:    record.SendTime,
+				Seq:         record.Seq,
+			}, nil
+		}
+		defer func() {
+			if err == nil && resp != nil {
+				return
+			}
+			// 已投递到mq的消息不会被释放
+			if releaseErr := m.MsgDatabase.ReleaseClientMsgID(ctx, req.MsgData); releaseErr != nil {
+				log.ZWarn(ctx, "release clientMsgID failed", releaseErr, "clientMsgID", req.MsgData.ClientMsgID)
+			}
+		}()
+		if err = m.threadVerification(ctx, req.MsgData); err != nil {
+			return nil, err
+		}
 		switch req.MsgData.SessionType {
 		case constant.SingleChatType:
 			return m.sendMsgSingleChat(ctx, req)
@@ -56,7 +82,8 @@ func (m *msgServer) sendMsgSuperGroupChat(
 	req *pbMsg.SendMsgReq,
 ) (resp *pbMsg.SendMsgResp, err error) {
 	promePkg.Inc(promePkg.WorkSuperGroupChatMsgRecvSuccessCounter)
-	if err = m.messageVerification(ctx, req); err != nil {
+	groupInfo, err := m.verifyMessage(ctx, req)
+	if err != nil {
 		promePkg.Inc(promePkg.WorkSuperGroupChatMsgProcessFailedCounter)
 		return nil, err
 	}
@@ -70,6 +97,7 @@ func (m *msgServer) sendMsgSuperGroupChat(
 	if err != nil {
 		return nil, err
 	}
+	m.initMsgDeliveryStatus(ctx, req.MsgData, groupInfo)
 	if req.MsgData.ContentType == constant.AtText {
 		go m.setConversationAtInfo(ctx, req.MsgData)
 	}
@@ -134,6 +162,7 @@ func (m *msgServer) sendMsgNotification(
 		promePkg.Inc(promePkg.SingleChatMsgProcessFailedCounter)
 		return nil, err
 	}
+	m.initMsgDeliveryStatus(ctx, req.MsgData, nil)
 	resp = &pbMsg.SendMsgResp{
 		ServerMsgID: req.MsgData.ServerMsgID,
 		ClientMsgID: req.MsgData.ClientMsgID,
@@ -175,6 +204,7 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbMsg.SendMsgReq
 			promePkg.Inc(promePkg.SingleChatMsgProcessFailedCounter)
 			return nil, err
 		}
+		m.initMsgDeliveryStatus(ctx, req.MsgData, nil)
 		err = callbackAfterSendSingleMsg(ctx, req)
 		if err != nil {
 			log.ZWarn(ctx, "CallbackAfterSendSingleMsg", err, "req", req)
@@ -23,27 +23,36 @@ import (
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/localcache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/search"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/locker"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/prome"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/discoveryregistry"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/conversation"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/rpcclient"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/rpcclient/notification"
 )
 
 type (
 	MessageInterceptorChain []MessageInterceptorFunc
 	msgServer               struct {
-		RegisterCenter         discoveryregistry.SvcDiscoveryRegistry
-		MsgDatabase            controller.CommonMsgDatabase
-		Group                  *rpcclient.GroupRpcClient
-		User                   *rpcclient.UserRpcClient
-		Conversation           *rpcclient.ConversationRpcClient
-		friend                 *rpcclient.FriendRpcClient
-		GroupLocalCache        *localcache.GroupLocalCache
-		ConversationLocalCache *localcache.ConversationLocalCache
-		Handlers               MessageInterceptorChain
-		notificationSender     *rpcclient.NotificationSender
+		RegisterCenter          discoveryregistry.SvcDiscoveryRegistry
+		MsgDatabase             controller.CommonMsgDatabase
+		ScheduledMsgDatabase    controller.ScheduledMsgDatabase
+		PinnedMsgDatabase       controller.PinnedMsgDatabase
+		RetentionPolicyDatabase controller.RetentionPolicyDatabase
+		EphemeralDatabase       controller.EphemeralDatabase
+		Group                   *rpcclient.GroupRpcClient
+		User                    *rpcclient.UserRpcClient
+		Conversation            *rpcclient.ConversationRpcClient
+		friend                  *rpcclient.FriendRpcClient
+		Push                    *rpcclient.PushRpcClient
+		GroupLocalCache         *localcache.GroupLocalCache
+		ConversationLocalCache  *localcache.ConversationLocalCache
+		Handlers                MessageInterceptorChain
+		notificationSender      *notification.MsgNotificationSender
+		MessageLocker           locker.MessageLocker
 	}
 )
 
@@ -74,24 +83,51 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 	if err := mongo.CreateMsgIndex(); err != nil {
 		return err
 	}
+	if err := mongo.CreateScheduledMsgIndex(); err != nil {
+		return err
+	}
+	if err := mongo.CreateThreadIndex(); err != nil {
+		return err
+	}
+	if err := mongo.CreatePinnedMsgIndex(); err != nil {
+		return err
+	}
+	if err := mongo.CreateRetentionPolicyIndex(); err != nil {
+		return err
+	}
 	cacheModel := cache.NewMsgCacheModel(rdb)
 	msgDocModel := unrelation.NewMsgMongoDriver(mongo.GetDatabase())
+	threadModel := unrelation.NewThreadMongoDriver(mongo.GetDatabase())
 	conversationClient := rpcclient.NewConversationRpcClient(client)
 	userRpcClient := rpcclient.NewUserRpcClient(client)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client)
 	friendRpcClient := rpcclient.NewFriendRpcClient(client)
-	msgDatabase := controller.NewCommonMsgDatabase(msgDocModel, cacheModel)
+	pushRpcClient := rpcclient.NewPushRpcClient(client)
+	searchIndex, err := search.NewMessageSearchIndex()
+	if err != nil {
+		return err
+	}
+	msgDatabase, err := controller.NewCommonMsgDatabase(msgDocModel, threadModel, cacheModel, searchIndex)
+	if err != nil {
+		return err
+	}
 	s := &msgServer{
-		Conversation:           &conversationClient,
-		User:                   &userRpcClient,
-		Group:                  &groupRpcClient,
-		MsgDatabase:            msgDatabase,
-		RegisterCenter:         client,
-		GroupLocalCache:        localcache.NewGroupLocalCache(&groupRpcClient),
-		ConversationLocalCache: localcache.NewConversationLocalCache(&conversationClient),
-		friend:                 &friendRpcClient,
+		Conversation:            &conversationClient,
+		User:                    &userRpcClient,
+		Group:                   &groupRpcClient,
+		MsgDatabase:             msgDatabase,
+		ScheduledMsgDatabase:    controller.NewScheduledMsgDatabase(unrelation.NewScheduledMsgMongoDriver(mongo.GetDatabase())),
+		PinnedMsgDatabase:       controller.NewPinnedMsgDatabase(unrelation.NewPinnedMsgMongoDriver(mongo.GetDatabase())),
+		RetentionPolicyDatabase: controller.NewRetentionPolicyDatabase(unrelation.NewRetentionPolicyMongoDriver(mongo.GetDatabase())),
+		EphemeralDatabase:       controller.NewEphemeralDatabase(cacheModel),
+		RegisterCenter:          client,
+		GroupLocalCache:         localcache.NewGroupLocalCache(&groupRpcClient),
+		ConversationLocalCache:  localcache.NewConversationLocalCache(&conversationClient),
+		friend:                  &friendRpcClient,
+		Push:                    &pushRpcClient,
+		MessageLocker:           locker.NewLockerMessage(cacheModel),
 	}
-	s.notificationSender = rpcclient.NewNotificationSender(rpcclient.WithLocalSendMsg(s.SendMsg))
+	s.notificationSender = notification.NewMsgNotificationSender(rpcclient.WithLocalSendMsg(s.SendMsg))
 	s.addInterceptorHandler(MessageHasReadEnabled)
 	s.initPrometheus()
 	msg.RegisterMsgServer(server, s)
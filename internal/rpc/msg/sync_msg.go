@@ -22,6 +22,7 @@ import (
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
 )
@@ -111,6 +112,11 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 	var chatLogs []*sdkws.MsgData
 	var total int32
 	resp = &msg.SearchMessageResp{}
+	if ok, err := m.checkSearchScope(ctx, req); err != nil {
+		return nil, err
+	} else if !ok {
+		return resp, nil
+	}
 	if total, chatLogs, err = m.MsgDatabase.SearchMessage(ctx, req); err != nil {
 		return nil, err
 	}
@@ -152,3 +158,31 @@ func (m *msgServer) SearchMessage(ctx context.Context, req *msg.SearchMessageReq
 	resp.ChatLogsNum = total
 	return resp, nil
 }
+
+// checkSearchScope limits a keyword search to the conversations of req.UserID, searching without a user is only for admins.
+// It returns false if the user has no conversation to search.
+func (m *msgServer) checkSearchScope(ctx context.Context, req *msg.SearchMessageReq) (bool, error) {
+	if req.Keyword == "" {
+		return true, nil
+	}
+	if req.UserID == "" {
+		return true, tokenverify.CheckAdmin(ctx)
+	}
+	if err := tokenverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return false, err
+	}
+	conversationIDs, err := m.Conversation.GetConversationIDs(ctx, req.UserID)
+	if err != nil {
+		return false, err
+	}
+	if len(req.ConversationIDs) > 0 {
+		for _, conversationID := range req.ConversationIDs {
+			if !utils.IsContain(conversationID, conversationIDs) {
+				return false, errs.ErrNoPermission.Wrap("not in conversation " + conversationID)
+			}
+		}
+		return true, nil
+	}
+	req.ConversationIDs = conversationIDs
+	return len(conversationIDs) > 0, nil
+}
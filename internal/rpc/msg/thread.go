@@ -0,0 +1,156 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+func (m *msgServer) GetThreadsInfo(ctx context.Context, req *msg.GetThreadsInfoReq) (*msg.GetThreadsInfoResp, error) {
+	if err := m.checkConversationAccess(ctx, req.UserID, req.ConversationID); err != nil {
+		return nil, err
+	}
+	threads, err := m.MsgDatabase.GetThreads(ctx, req.ConversationID, req.RootSeqs)
+	if err != nil {
+		return nil, err
+	}
+	threadMap := make(map[int64]*unRelationTb.ThreadModel, len(threads))
+	for _, thread := range threads {
+		threadMap[thread.RootSeq] = thread
+	}
+	hasReadSeqs, err := m.MsgDatabase.GetThreadHasReadSeqs(ctx, req.UserID, req.ConversationID, req.RootSeqs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.GetThreadsInfoResp{}
+	for _, rootSeq := range req.RootSeqs {
+		info := &msg.ThreadInfo{RootSeq: rootSeq, HasReadSeq: hasReadSeqs[rootSeq]}
+		if thread, ok := threadMap[rootSeq]; ok {
+			info.ReplyCount = thread.ReplyCount
+			info.LastReplySeq = thread.LastReplySeq
+			info.LastReplyTime = thread.LastReplyTime
+		}
+		if info.ReplyCount > info.HasReadSeq {
+			info.UnreadCount = info.ReplyCount - info.HasReadSeq
+		}
+		resp.Threads = append(resp.Threads, info)
+	}
+	return resp, nil
+}
+
+// PullThreadMsgs pulls replies by thread seq, which is the position of the reply in the thread starting from 1.
+func (m *msgServer) PullThreadMsgs(ctx context.Context, req *msg.PullThreadMsgsReq) (*msg.PullThreadMsgsResp, error) {
+	thread, err := m.getThread(ctx, req.UserID, req.ConversationID, req.RootSeq)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.PullThreadMsgsResp{MaxSeq: thread.ReplyCount}
+	if req.Begin > thread.ReplyCount {
+		return resp, nil
+	}
+	seqs, err := m.MsgDatabase.GetThreadReplySeqs(ctx, req.ConversationID, req.RootSeq, req.Begin, req.End)
+	if err != nil {
+		return nil, err
+	}
+	if len(seqs) == 0 {
+		return resp, nil
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, req.UserID, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	msgMap := make(map[int64]*sdkws.MsgData, len(msgs))
+	for _, msgData := range msgs {
+		if msgData != nil {
+			msgMap[msgData.Seq] = msgData
+		}
+	}
+	for i, seq := range seqs {
+		msgData, ok := msgMap[seq]
+		if !ok {
+			// 用户已删除或已过期的回复
+			continue
+		}
+		resp.Msgs = append(resp.Msgs, &msg.ThreadMsg{ThreadSeq: req.Begin + int64(i), Msg: msgData})
+	}
+	return resp, nil
+}
+
+func (m *msgServer) SetThreadHasReadSeq(ctx context.Context, req *msg.SetThreadHasReadSeqReq) (*msg.SetThreadHasReadSeqResp, error) {
+	thread, err := m.getThread(ctx, req.UserID, req.ConversationID, req.RootSeq)
+	if err != nil {
+		return nil, err
+	}
+	if req.HasReadSeq > thread.ReplyCount {
+		return nil, errs.ErrArgs.Wrap("hasReadSeq must not be bigger than replyCount")
+	}
+	if err := m.MsgDatabase.SetThreadHasReadSeq(ctx, req.UserID, req.ConversationID, req.RootSeq, req.HasReadSeq); err != nil {
+		return nil, err
+	}
+	return &msg.SetThreadHasReadSeqResp{}, nil
+}
+
+// getThread returns an empty thread when the root has no reply yet.
+func (m *msgServer) getThread(ctx context.Context, userID, conversationID string, rootSeq int64) (*unRelationTb.ThreadModel, error) {
+	if err := m.checkConversationAccess(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+	threads, err := m.MsgDatabase.GetThreads(ctx, conversationID, []int64{rootSeq})
+	if err != nil {
+		return nil, err
+	}
+	if len(threads) == 0 {
+		return &unRelationTb.ThreadModel{ConversationID: conversationID, RootSeq: rootSeq}, nil
+	}
+	return threads[0], nil
+}
+
+// threadVerification checks the root msg a reply is sent to.
+func (m *msgServer) threadVerification(ctx context.Context, data *sdkws.MsgData) error {
+	if data.ThreadRootSeq == 0 {
+		return nil
+	}
+	if data.ThreadRootSeq < 0 {
+		return errs.ErrArgs.Wrap("threadRootSeq is invalid")
+	}
+	if data.ContentType >= constant.NotificationBegin {
+		return errs.ErrArgs.Wrap("notification can not be a thread reply")
+	}
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, data.SendID, utils.GetConversationIDByMsg(data), []int64{data.ThreadRootSeq})
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 || msgs[0] == nil {
+		return errs.ErrRecordNotFound.Wrap("thread root msg not found")
+	}
+	root := msgs[0]
+	if root.ContentType == constant.MsgRevokeNotification {
+		return errs.ErrMsgAlreadyRevoke.Wrap("thread root msg already revoke")
+	}
+	if root.ContentType >= constant.NotificationBegin {
+		return errs.ErrArgs.Wrap("notification can not be a thread root")
+	}
+	if root.ThreadRootSeq != 0 {
+		return errs.ErrArgs.Wrap("thread reply can not be a thread root")
+	}
+	return nil
+}
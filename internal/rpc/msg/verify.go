@@ -48,51 +48,57 @@ type MessageRevoked struct {
 }
 
 func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgReq) error {
+	_, err := m.verifyMessage(ctx, data)
+	return err
+}
+
+// verifyMessage 超级群消息同时返回校验时获取的群信息, 其他消息返回nil.
+func (m *msgServer) verifyMessage(ctx context.Context, data *msg.SendMsgReq) (*sdkws.GroupInfo, error) {
 	switch data.MsgData.SessionType {
 	case constant.SingleChatType:
 		if utils.IsContain(data.MsgData.SendID, config.Config.Manager.UserID) {
-			return nil
+			return nil, nil
 		}
 		if data.MsgData.ContentType <= constant.NotificationEnd &&
 			data.MsgData.ContentType >= constant.NotificationBegin {
-			return nil
+			return nil, nil
 		}
 		black, err := m.friend.IsBlocked(ctx, data.MsgData.SendID, data.MsgData.RecvID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if black {
-			return errs.ErrBlockedByPeer.Wrap()
+			return nil, errs.ErrBlockedByPeer.Wrap()
 		}
 		if *config.Config.MessageVerify.FriendVerify {
 			friend, err := m.friend.IsFriend(ctx, data.MsgData.SendID, data.MsgData.RecvID)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if !friend {
-				return errs.ErrNotPeersFriend.Wrap()
+				return nil, errs.ErrNotPeersFriend.Wrap()
 			}
-			return nil
+			return nil, nil
 		}
-		return nil
+		return nil, nil
 	case constant.SuperGroupChatType:
 		groupInfo, err := m.Group.GetGroupInfoCache(ctx, data.MsgData.GroupID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if groupInfo.Status == constant.GroupStatusDismissed &&
 			data.MsgData.ContentType != constant.GroupDismissedNotification {
-			return errs.ErrDismissedAlready.Wrap()
+			return nil, errs.ErrDismissedAlready.Wrap()
 		}
 		if groupInfo.GroupType == constant.SuperGroup {
-			return nil
+			return groupInfo, nil
 		}
 		if utils.IsContain(data.MsgData.SendID, config.Config.Manager.UserID) {
-			return nil
+			return groupInfo, nil
 		}
 		if data.MsgData.ContentType <= constant.NotificationEnd &&
 			data.MsgData.ContentType >= constant.NotificationBegin {
-			return nil
+			return groupInfo, nil
 		}
 		// memberIDs, err := m.GroupLocalCache.GetGroupMemberIDs(ctx, data.MsgData.GroupID)
 		// if err != nil {
@@ -105,23 +111,23 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 		groupMemberInfo, err := m.Group.GetGroupMemberCache(ctx, data.MsgData.GroupID, data.MsgData.SendID)
 		if err != nil {
 			if err == errs.ErrRecordNotFound {
-				return errs.ErrNotInGroupYet.Wrap(err.Error())
+				return nil, errs.ErrNotInGroupYet.Wrap(err.Error())
 			}
-			return err
+			return nil, err
 		}
 		if groupMemberInfo.RoleLevel == constant.GroupOwner {
-			return nil
+			return groupInfo, nil
 		} else {
 			if groupMemberInfo.MuteEndTime >= time.Now().Unix() {
-				return errs.ErrMutedInGroup.Wrap()
+				return nil, errs.ErrMutedInGroup.Wrap()
 			}
 			if groupInfo.Status == constant.GroupStatusMuted && groupMemberInfo.RoleLevel != constant.GroupAdmin {
-				return errs.ErrMutedGroup.Wrap()
+				return nil, errs.ErrMutedGroup.Wrap()
 			}
 		}
-		return nil
+		return groupInfo, nil
 	default:
-		return nil
+		return nil, nil
 	}
 }
 
@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/controller"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/discoveryregistry"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/rtc"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/rpcclient"
+)
+
+type rtcServer struct {
+	signalDatabase controller.SignalDatabase
+	msgRpcClient   *rpcclient.MessageRpcClient
+	groupRpcClient *rpcclient.GroupRpcClient
+}
+
+func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) error {
+	rdb, err := cache.NewRedis()
+	if err != nil {
+		return err
+	}
+	msgRpcClient := rpcclient.NewMessageRpcClient(client)
+	groupRpcClient := rpcclient.NewGroupRpcClient(client)
+	s := &rtcServer{
+		signalDatabase: controller.NewSignalDatabase(cache.NewMsgCacheModel(rdb)),
+		msgRpcClient:   &msgRpcClient,
+		groupRpcClient: &groupRpcClient,
+	}
+	rtc.RegisterRtcServiceServer(server, s)
+	go s.signalTimeoutLoop()
+	return nil
+}
+
+func (r *rtcServer) SignalGetPendingInvitations(
+	ctx context.Context,
+	req *rtc.SignalGetPendingInvitationsReq,
+) (*rtc.SignalGetPendingInvitationsResp, error) {
+	if err := tokenverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	invitations, err := r.signalDatabase.GetPendingInvitations(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &rtc.SignalGetPendingInvitationsResp{Invitations: invitations}, nil
+}
@@ -0,0 +1,436 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rtc
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/rtc"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const (
+	defaultSignalTimeout = 60
+
+	signalTimeoutScanInterval = time.Second
+	signalTimeoutScanCount    = 100
+
+	lockRoomRetryTimes    = 20
+	lockRoomRetryInterval = time.Millisecond * 50
+)
+
+// SignalMessageAssemble 处理客户端的信令, 更新房间状态后以信令通知转发给房间内的其他用户.
+func (r *rtcServer) SignalMessageAssemble(
+	ctx context.Context,
+	req *rtc.SignalMessageAssembleReq,
+) (*rtc.SignalMessageAssembleResp, error) {
+	if req.SignalReq == nil {
+		return nil, errs.ErrArgs.Wrap("signalReq is empty")
+	}
+	var (
+		sendID          string
+		notifyUserIDs   []string
+		offlinePushInfo *sdkws.OfflinePushInfo
+		err             error
+	)
+	resp := &sdkws.SignalResp{}
+	switch payload := req.SignalReq.Payload.(type) {
+	case *sdkws.SignalReq_Invite:
+		sendID, offlinePushInfo = payload.Invite.UserID, payload.Invite.OfflinePushInfo
+		notifyUserIDs, err = r.invite(ctx, payload.Invite.UserID, payload.Invite.Invitation, constant.SingleChatType)
+		if err == nil {
+			resp.Payload = &sdkws.SignalResp_Invite{Invite: &sdkws.SignalInviteResp{
+				RoomID:             payload.Invite.Invitation.RoomID,
+				BusyLineUserIDList: payload.Invite.Invitation.BusyLineUserIDList,
+			}}
+		}
+	case *sdkws.SignalReq_InviteInGroup:
+		sendID, offlinePushInfo = payload.InviteInGroup.UserID, payload.InviteInGroup.OfflinePushInfo
+		notifyUserIDs, err = r.invite(ctx, payload.InviteInGroup.UserID, payload.InviteInGroup.Invitation, constant.SuperGroupChatType)
+		if err == nil {
+			resp.Payload = &sdkws.SignalResp_InviteInGroup{InviteInGroup: &sdkws.SignalInviteInGroupResp{
+				RoomID:             payload.InviteInGroup.Invitation.RoomID,
+				BusyLineUserIDList: payload.InviteInGroup.Invitation.BusyLineUserIDList,
+			}}
+		}
+	case *sdkws.SignalReq_Accept:
+		sendID, offlinePushInfo = payload.Accept.UserID, payload.Accept.OfflinePushInfo
+		payload.Accept.Invitation, notifyUserIDs, err = r.answer(ctx, payload.Accept.UserID, payload.Accept.Invitation, constant.SignalStateJoined)
+		if err == nil {
+			resp.Payload = &sdkws.SignalResp_Accept{Accept: &sdkws.SignalAcceptResp{RoomID: payload.Accept.Invitation.RoomID}}
+		}
+	case *sdkws.SignalReq_Reject:
+		sendID, offlinePushInfo = payload.Reject.UserID, payload.Reject.OfflinePushInfo
+		payload.Reject.Invitation, notifyUserIDs, err = r.answer(ctx, payload.Reject.UserID, payload.Reject.Invitation, constant.SignalStateRejected)
+		if err == nil {
+			resp.Payload = &sdkws.SignalResp_Reject{Reject: &sdkws.SignalRejectResp{}}
+		}
+	case *sdkws.SignalReq_Cancel:
+		sendID, offlinePushInfo = payload.Cancel.UserID, payload.Cancel.OfflinePushInfo
+		payload.Cancel.Invitation, notifyUserIDs, err = r.cancel(ctx, payload.Cancel.UserID, payload.Cancel.Invitation)
+		if err == nil {
+			resp.Payload = &sdkws.SignalResp_Cancel{Cancel: &sdkws.SignalCancelResp{}}
+		}
+	case *sdkws.SignalReq_HungUp:
+		sendID, offlinePushInfo = payload.HungUp.UserID, payload.HungUp.OfflinePushInfo
+		payload.HungUp.Invitation, notifyUserIDs, err = r.hungUp(ctx, payload.HungUp.UserID, payload.HungUp.Invitation)
+		if err == nil {
+			resp.Payload = &sdkws.SignalResp_HungUp{HungUp: &sdkws.SignalHungUpResp{}}
+		}
+	case *sdkws.SignalReq_Timeout:
+		return nil, errs.ErrArgs.Wrap("timeout signal is generated by server")
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown signal payload")
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.sendSignal(ctx, sendID, notifyUserIDs, req.SignalReq, offlinePushInfo)
+	return &rtc.SignalMessageAssembleResp{SignalResp: resp}, nil
+}
+
+// invite 创建房间并返回需要通知的被邀请者, 忙线的用户放到busyLineUserIDList中不再邀请.
+func (r *rtcServer) invite(ctx context.Context, userID string, invitation *sdkws.InvitationInfo, sessionType int32) ([]string, error) {
+	if err := tokenverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, errs.ErrArgs.Wrap("invitation is empty")
+	}
+	if invitation.InviterUserID != userID {
+		return nil, errs.ErrArgs.Wrap("inviterUserID must be the operator")
+	}
+	inviteeUserIDs := utils.Filter(utils.Distinct(invitation.InviteeUserIDList), func(e string) (string, bool) {
+		return e, e != userID
+	})
+	if len(inviteeUserIDs) == 0 {
+		return nil, errs.ErrArgs.Wrap("inviteeUserIDList is empty")
+	}
+	switch sessionType {
+	case constant.SingleChatType:
+		if len(inviteeUserIDs) != 1 {
+			return nil, errs.ErrArgs.Wrap("single chat invitation must have one invitee")
+		}
+		invitation.GroupID = ""
+	case constant.SuperGroupChatType:
+		if invitation.GroupID == "" {
+			return nil, errs.ErrArgs.Wrap("groupID is empty")
+		}
+		memberIDs, err := r.groupRpcClient.GetGroupMemberIDs(ctx, invitation.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if !utils.IsContain(userID, memberIDs) {
+			return nil, errs.ErrNotInGroupYet.Wrap("inviter not in group")
+		}
+		for _, inviteeUserID := range inviteeUserIDs {
+			if !utils.IsContain(inviteeUserID, memberIDs) {
+				return nil, errs.ErrArgs.Wrap("invitee not in group " + inviteeUserID)
+			}
+		}
+	}
+	// 指定的房间已存在时由CreateRoom返回错误
+	if invitation.RoomID == "" {
+		invitation.RoomID = utils.GetMsgID(userID)
+	}
+	if invitation.Timeout <= 0 {
+		invitation.Timeout = int32(config.Config.Rtc.SignalTimeout)
+		if invitation.Timeout <= 0 {
+			invitation.Timeout = defaultSignalTimeout
+		}
+	}
+	invitation.SessionType = sessionType
+	invitation.InitiateTime = utils.GetCurrentTimestampByMill()
+	busyUsers, err := r.signalDatabase.GetBusyUsers(ctx, inviteeUserIDs)
+	if err != nil {
+		return nil, err
+	}
+	invitation.InviteeUserIDList, invitation.BusyLineUserIDList = nil, nil
+	for _, inviteeUserID := range inviteeUserIDs {
+		if _, ok := busyUsers[inviteeUserID]; ok {
+			invitation.BusyLineUserIDList = append(invitation.BusyLineUserIDList, inviteeUserID)
+		} else {
+			invitation.InviteeUserIDList = append(invitation.InviteeUserIDList, inviteeUserID)
+		}
+	}
+	// 全部忙线时不创建房间, 返回空的roomID
+	if len(invitation.InviteeUserIDList) == 0 {
+		log.ZInfo(ctx, "all invitees are busy", "busyLineUserIDList", invitation.BusyLineUserIDList)
+		invitation.RoomID = ""
+		return nil, nil
+	}
+	states := map[string]int32{userID: constant.SignalStateJoined}
+	for _, inviteeUserID := range invitation.InviteeUserIDList {
+		states[inviteeUserID] = constant.SignalStateInvited
+	}
+	if err := r.signalDatabase.CreateRoom(ctx, invitation, states); err != nil {
+		return nil, err
+	}
+	return invitation.InviteeUserIDList, nil
+}
+
+// answer 被邀请者接受或拒绝邀请.
+func (r *rtcServer) answer(ctx context.Context, userID string, invitation *sdkws.InvitationInfo, state int32) (*sdkws.InvitationInfo, []string, error) {
+	if err := tokenverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, nil, err
+	}
+	room, states, unlock, err := r.lockRoom(ctx, invitation)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+	if states[userID] != constant.SignalStateInvited {
+		return nil, nil, errs.ErrArgs.Wrap("not invited or already answered")
+	}
+	if err := r.setUsersState(ctx, room.RoomID, states, map[string]int32{userID: state}); err != nil {
+		return nil, nil, err
+	}
+	return room, activeUserIDs(states, userID), nil
+}
+
+// cancel 邀请者取消还没有应答的邀请.
+func (r *rtcServer) cancel(ctx context.Context, userID string, invitation *sdkws.InvitationInfo) (*sdkws.InvitationInfo, []string, error) {
+	if err := tokenverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, nil, err
+	}
+	room, states, unlock, err := r.lockRoom(ctx, invitation)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+	if room.InviterUserID != userID {
+		return nil, nil, errs.ErrNoPermission.Wrap("only the inviter can cancel")
+	}
+	canceled := make(map[string]int32)
+	for uid, s := range states {
+		if s == constant.SignalStateInvited {
+			canceled[uid] = constant.SignalStateCanceled
+		}
+	}
+	if len(canceled) == 0 {
+		return nil, nil, errs.ErrArgs.Wrap("no invitation to cancel")
+	}
+	// 被取消的用户也需要收到通知
+	notifyUserIDs := activeUserIDs(states, userID)
+	if err := r.setUsersState(ctx, room.RoomID, states, canceled); err != nil {
+		return nil, nil, err
+	}
+	return room, notifyUserIDs, nil
+}
+
+// hungUp 通话中的用户离开房间.
+func (r *rtcServer) hungUp(ctx context.Context, userID string, invitation *sdkws.InvitationInfo) (*sdkws.InvitationInfo, []string, error) {
+	if err := tokenverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, nil, err
+	}
+	room, states, unlock, err := r.lockRoom(ctx, invitation)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+	if states[userID] != constant.SignalStateJoined {
+		return nil, nil, errs.ErrArgs.Wrap("not in the call")
+	}
+	notifyUserIDs := activeUserIDs(states, userID)
+	if err := r.setUsersState(ctx, room.RoomID, states, map[string]int32{userID: constant.SignalStateHungUp}); err != nil {
+		return nil, nil, err
+	}
+	return room, notifyUserIDs, nil
+}
+
+// lockRoom 锁住房间并返回房间的邀请信息和参与者状态.
+func (r *rtcServer) lockRoom(ctx context.Context, invitation *sdkws.InvitationInfo) (*sdkws.InvitationInfo, map[string]int32, func(), error) {
+	if invitation == nil || invitation.RoomID == "" {
+		return nil, nil, nil, errs.ErrArgs.Wrap("roomID is empty")
+	}
+	roomID := invitation.RoomID
+	var (
+		token string
+		err   error
+	)
+	for i := 0; i < lockRoomRetryTimes; i++ {
+		if token, err = r.signalDatabase.LockRoom(ctx, roomID); err == nil {
+			break
+		}
+		time.Sleep(lockRoomRetryInterval)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	unlock := func() {
+		if err := r.signalDatabase.UnLockRoom(ctx, roomID, token); err != nil {
+			log.ZWarn(ctx, "UnLockRoom failed", err, "roomID", roomID)
+		}
+	}
+	room, states, err := r.signalDatabase.TakeRoom(ctx, roomID)
+	if err != nil {
+		unlock()
+		if errs.Unwrap(err) == redis.Nil {
+			return nil, nil, nil, errs.ErrRecordNotFound.Wrap("room not found or already closed")
+		}
+		return nil, nil, nil, err
+	}
+	return room, states, unlock, nil
+}
+
+// setUsersState 更新状态, 没有通话中的用户或只剩一个人且没有待应答的邀请时关闭房间.
+func (r *rtcServer) setUsersState(ctx context.Context, roomID string, states map[string]int32, changed map[string]int32) error {
+	if err := r.signalDatabase.SetUsersState(ctx, roomID, changed); err != nil {
+		return err
+	}
+	var joined, invited int
+	for userID, state := range states {
+		if s, ok := changed[userID]; ok {
+			state = s
+		}
+		switch state {
+		case constant.SignalStateJoined:
+			joined++
+		case constant.SignalStateInvited:
+			invited++
+		}
+	}
+	if joined == 0 || (joined == 1 && invited == 0) {
+		log.ZInfo(ctx, "close signal room", "roomID", roomID, "states", states)
+		return r.signalDatabase.CloseRoom(ctx, roomID, utils.Keys(states))
+	}
+	return nil
+}
+
+// activeUserIDs 房间中还在邀请中或通话中的用户.
+func activeUserIDs(states map[string]int32, exceptUserID string) []string {
+	var userIDs []string
+	for userID, state := range states {
+		if userID == exceptUserID {
+			continue
+		}
+		if state == constant.SignalStateInvited || state == constant.SignalStateJoined {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs
+}
+
+// sendSignal 以信令通知发给每个用户, 只有邀请需要离线推送.
+func (r *rtcServer) sendSignal(ctx context.Context, sendID string, recvIDs []string, signalReq *sdkws.SignalReq, offlinePushInfo *sdkws.OfflinePushInfo) {
+	if len(recvIDs) == 0 {
+		return
+	}
+	content, err := proto.Marshal(signalReq)
+	if err != nil {
+		log.ZError(ctx, "marshal signalReq failed", err)
+		return
+	}
+	var isOfflinePush bool
+	switch signalReq.Payload.(type) {
+	case *sdkws.SignalReq_Invite, *sdkws.SignalReq_InviteInGroup:
+		isOfflinePush = true
+	}
+	for _, recvID := range recvIDs {
+		msgData := &sdkws.MsgData{
+			SendID:          sendID,
+			RecvID:          recvID,
+			ClientMsgID:     utils.GetMsgID(sendID),
+			SessionType:     constant.SingleChatType,
+			MsgFrom:         constant.SysMsgType,
+			ContentType:     constant.SignalingNotification,
+			Content:         content,
+			CreateTime:      utils.GetCurrentTimestampByMill(),
+			Options:         utils.NewOptions(utils.WithOfflinePush(isOfflinePush)),
+			OfflinePushInfo: offlinePushInfo,
+		}
+		if _, err := r.msgRpcClient.SendMsg(ctx, &msg.SendMsgReq{MsgData: msgData}); err != nil {
+			log.ZWarn(ctx, "send signal failed", err, "sendID", sendID, "recvID", recvID)
+		}
+	}
+}
+
+// signalTimeoutLoop 定时扫描到期的邀请, 多实例时由TakeTimeoutRoomIDs保证每个房间只处理一次.
+func (r *rtcServer) signalTimeoutLoop() {
+	ticker := time.NewTicker(signalTimeoutScanInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := mcontext.NewCtx("signalTimeout-" + utils.OperationIDGenerator())
+		if len(config.Config.Manager.UserID) > 0 {
+			ctx = mcontext.WithOpUserIDContext(ctx, config.Config.Manager.UserID[0])
+		}
+		roomIDs, err := r.signalDatabase.TakeTimeoutRoomIDs(ctx, signalTimeoutScanCount)
+		if err != nil {
+			log.ZWarn(ctx, "TakeTimeoutRoomIDs failed", err)
+			continue
+		}
+		for _, roomID := range roomIDs {
+			if err := r.timeoutRoom(ctx, roomID); err != nil {
+				// 保留在超时队列中, lease到期后重试
+				log.ZWarn(ctx, "timeout signal room failed", err, "roomID", roomID)
+				continue
+			}
+			if err := r.signalDatabase.DoneTimeoutRoom(ctx, roomID); err != nil {
+				log.ZWarn(ctx, "DoneTimeoutRoom failed", err, "roomID", roomID)
+			}
+		}
+	}
+}
+
+func (r *rtcServer) timeoutRoom(ctx context.Context, roomID string) error {
+	room, states, unlock, err := r.lockRoom(ctx, &sdkws.InvitationInfo{RoomID: roomID})
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return nil
+		}
+		return err
+	}
+	defer unlock()
+	timeout := make(map[string]int32)
+	for userID, state := range states {
+		if state == constant.SignalStateInvited {
+			timeout[userID] = constant.SignalStateTimeout
+		}
+	}
+	if len(timeout) == 0 {
+		return nil
+	}
+	var joinedUserIDs []string
+	for userID, state := range states {
+		if state == constant.SignalStateJoined {
+			joinedUserIDs = append(joinedUserIDs, userID)
+		}
+	}
+	if err := r.setUsersState(ctx, roomID, states, timeout); err != nil {
+		return err
+	}
+	for userID := range timeout {
+		signalReq := &sdkws.SignalReq{Payload: &sdkws.SignalReq_Timeout{Timeout: &sdkws.SignalTimeoutReq{
+			UserID:     userID,
+			Invitation: room,
+		}}}
+		// 通知通话中的用户, 再以邀请者的身份通知超时的被邀请者
+		r.sendSignal(ctx, userID, joinedUserIDs, signalReq, nil)
+		r.sendSignal(ctx, room.InviterUserID, []string{userID}, signalReq, nil)
+	}
+	return nil
+}
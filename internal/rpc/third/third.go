@@ -20,10 +20,7 @@ import (
 	"net/url"
 	"time"
 
-	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3"
-	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/cos"
-	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/minio"
-	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/oss"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/engine"
 
 	"google.golang.org/grpc"
 
@@ -60,18 +57,7 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		return err
 	}
 	// 根据配置文件策略选择 oss 方式
-	enable := config.Config.Object.Enable
-	var o s3.Interface
-	switch config.Config.Object.Enable {
-	case "minio":
-		o, err = minio.NewMinio()
-	case "cos":
-		o, err = cos.NewCos()
-	case "oss":
-		o, err = oss.NewOSS()
-	default:
-		err = fmt.Errorf("invalid object enable: %s", enable)
-	}
+	o, err := engine.New()
 	if err != nil {
 		return err
 	}
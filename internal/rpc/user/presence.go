@@ -0,0 +1,104 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	pbuser "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/user"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+func (s *userServer) SetUserPresence(ctx context.Context, req *pbuser.SetUserPresenceReq) (*pbuser.SetUserPresenceResp, error) {
+	if err := tokenverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	switch req.CustomState {
+	case constant.PresenceCustomStateDefault, constant.PresenceCustomStateAway:
+	default:
+		return nil, errs.ErrArgs.Wrap("customState invalid")
+	}
+	if utf8.RuneCountInString(req.StatusText) > constant.PresenceStatusTextMaxLength {
+		return nil, errs.ErrArgs.Wrap("statusText too long")
+	}
+	if _, err := s.FindWithError(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	if err := s.presenceDatabase.SetUserCustomPresence(ctx, req.UserID, req.CustomState, req.StatusText); err != nil {
+		return nil, err
+	}
+	if err := s.presenceDatabase.PublishUsersPresence(ctx, []string{req.UserID}); err != nil {
+		return nil, err
+	}
+	return &pbuser.SetUserPresenceResp{}, nil
+}
+
+func (s *userServer) GetUsersPresence(ctx context.Context, req *pbuser.GetUsersPresenceReq) (*pbuser.GetUsersPresenceResp, error) {
+	if len(req.UserIDs) == 0 {
+		return nil, errs.ErrArgs.Wrap("userIDs is empty")
+	}
+	if len(req.UserIDs) > constant.PresenceMaxUserIDs {
+		return nil, errs.ErrArgs.Wrap("userIDs exceed limit")
+	}
+	if err := s.checkPresenceRelation(ctx, req.UserIDs); err != nil {
+		return nil, err
+	}
+	presences, err := s.presenceDatabase.GetUsersPresence(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &pbuser.GetUsersPresenceResp{Presences: presences}, nil
+}
+
+// checkPresenceRelation 只能查看自己, 好友和同群成员的在线状态, 管理员不限制.
+func (s *userServer) checkPresenceRelation(ctx context.Context, userIDs []string) error {
+	if tokenverify.IsAppManagerUid(ctx) {
+		return nil
+	}
+	opUserID := mcontext.GetOpUserID(ctx)
+	friendIDs, err := s.friendRpcClient.GetFriendIDs(ctx, opUserID)
+	if err != nil {
+		return err
+	}
+	friends := utils.SliceSet(friendIDs)
+	var others []string
+	for _, userID := range utils.Distinct(userIDs) {
+		if _, ok := friends[userID]; !ok && userID != opUserID {
+			others = append(others, userID)
+		}
+	}
+	if len(others) == 0 {
+		return nil
+	}
+	groupIDs, err := s.groupRpcClient.GetJoinedGroupIDs(ctx, opUserID)
+	if err != nil {
+		return err
+	}
+	for _, userID := range others {
+		ok, err := s.groupRpcClient.InAnyGroup(ctx, userID, groupIDs)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errs.ErrNoPermission.Wrap("not friend or group member: " + userID)
+		}
+	}
+	return nil
+}
@@ -47,7 +47,9 @@ type userServer struct {
 	controller.UserDatabase
 	notificationSender *notification.FriendNotificationSender
 	friendRpcClient    *rpcclient.FriendRpcClient
+	groupRpcClient     *rpcclient.GroupRpcClient
 	RegisterCenter     registry.SvcDiscoveryRegistry
+	presenceDatabase   controller.PresenceDatabase
 }
 
 func Start(client registry.SvcDiscoveryRegistry, server *grpc.Server) error {
@@ -70,14 +72,18 @@ func Start(client registry.SvcDiscoveryRegistry, server *grpc.Server) error {
 		users = append(users, &tablerelation.UserModel{UserID: v, Nickname: config.Config.Manager.Nickname[k], AppMangerLevel: constant.AppAdmin})
 	}
 	userDB := relation.NewUserGorm(db)
+	presenceDatabase := controller.NewPresenceDatabase(cache.NewMsgCacheModel(rdb))
 	cache := cache.NewUserCacheRedis(rdb, userDB, cache.GetDefaultOpt())
 	database := controller.NewUserDatabase(userDB, cache, tx.NewGorm(db))
 	friendRpcClient := rpcclient.NewFriendRpcClient(client)
+	groupRpcClient := rpcclient.NewGroupRpcClient(client)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client)
 	u := &userServer{
 		UserDatabase:       database,
 		RegisterCenter:     client,
+		presenceDatabase:   presenceDatabase,
 		friendRpcClient:    &friendRpcClient,
+		groupRpcClient:     &groupRpcClient,
 		notificationSender: notification.NewFriendNotificationSender(&msgRpcClient, notification.WithDBFunc(database.FindWithError)),
 	}
 	pbuser.RegisterUserServer(server, u)
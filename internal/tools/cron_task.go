@@ -46,6 +46,22 @@ func StartCronTask() error {
 		fmt.Println("start conversationsDestructMsgs cron failed", err.Error(), config.Config.ChatRecordsClearTime)
 		panic(err)
 	}
+	if config.Config.ScheduledMsgDispatchTime != "" {
+		log.ZInfo(context.Background(), "start scheduledMsgDispatch cron task", "cron config", config.Config.ScheduledMsgDispatchTime)
+		_, err = c.AddFunc(config.Config.ScheduledMsgDispatchTime, msgTool.DispatchScheduledMsgs)
+		if err != nil {
+			fmt.Println("start dispatchScheduledMsgs cron failed", err.Error(), config.Config.ScheduledMsgDispatchTime)
+			panic(err)
+		}
+	}
+	if config.Config.MsgArchive.Enable {
+		log.ZInfo(context.Background(), "start msgArchive cron task", "cron config", config.Config.MsgArchive.ArchiveTime)
+		_, err = c.AddFunc(config.Config.MsgArchive.ArchiveTime, msgTool.ArchiveMsgDocs)
+		if err != nil {
+			fmt.Println("start archiveMsgDocs cron failed", err.Error(), config.Config.MsgArchive.ArchiveTime)
+			panic(err)
+		}
+	}
 	c.Start()
 	wg.Wait()
 	return nil
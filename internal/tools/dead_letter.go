@@ -0,0 +1,145 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/kafka"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mq"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	pbMsg "github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+// DeadLetterTool 查看、重放或丢弃msgtransfer某个阶段的死信消息.
+type DeadLetterTool struct {
+	stage       string
+	replayTopic string
+	reader      *kafka.DeadLetterReader
+}
+
+func InitDeadLetterTool(stage string) (*DeadLetterTool, error) {
+	// 进程内队列的死信只存在于msgtransfer进程中, 无法在cmdutils中读取
+	if config.Config.MQ.Type == mq.TypeMemory {
+		return nil, errs.ErrArgs.Wrap("dead letter tool requires kafka, mq type is " + config.Config.MQ.Type)
+	}
+	var topic, replayTopic string
+	switch stage {
+	case constant.DeadLetterStageMsgToRedis:
+		topic, replayTopic = config.Config.Kafka.MsgToRedisDeadLetter.Topic, config.Config.Kafka.LatestMsgToRedis.Topic
+	case constant.DeadLetterStageMsgToMongo:
+		topic, replayTopic = config.Config.Kafka.MsgToMongoDeadLetter.Topic, config.Config.Kafka.MsgToMongo.Topic
+	case constant.DeadLetterStageMsgToSearch:
+		// 只重放给索引消费者, 不重新写入mongo
+		topic, replayTopic = config.Config.Kafka.MsgToSearchDeadLetter.Topic, config.Config.Kafka.MsgToSearch.Topic
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown dead letter stage " + stage)
+	}
+	reader, err := kafka.NewDeadLetterReader(config.Config.Kafka.Addr, topic, config.Config.Kafka.ConsumerGroupID.DeadLetter)
+	if err != nil {
+		return nil, err
+	}
+	return &DeadLetterTool{stage: stage, replayTopic: replayTopic, reader: reader}, nil
+}
+
+func (d *DeadLetterTool) Close() error {
+	return d.reader.Close()
+}
+
+// Inspect 返回尚未处理的死信, 不移动offset.
+func (d *DeadLetterTool) Inspect(limit int) ([]*pbMsg.DeadLetterMsg, error) {
+	var deadLetters []*pbMsg.DeadLetterMsg
+	_, err := d.reader.Range(limit, false, func(msg *sarama.ConsumerMessage) error {
+		deadLetter, err := unmarshalDeadLetter(msg)
+		if err != nil {
+			return err
+		}
+		deadLetters = append(deadLetters, deadLetter)
+		return nil
+	})
+	return deadLetters, err
+}
+
+// Replay 把死信批次重新投递到失败阶段的源topic, 由msgtransfer重新处理, 返回重放的批次数.
+func (d *DeadLetterTool) Replay(limit int) (int, error) {
+	// 与msg rpc/msgtransfer使用相同的生产者, mq.type不是kafka时在InitDeadLetterTool中已拒绝
+	producer, err := mq.NewProducer(d.replayTopic)
+	if err != nil {
+		return 0, err
+	}
+	return d.reader.Range(limit, true, func(msg *sarama.ConsumerMessage) error {
+		deadLetter, err := unmarshalDeadLetter(msg)
+		if err != nil {
+			return err
+		}
+		operationID := deadLetter.OperationID
+		if operationID == "" {
+			operationID = utils.OperationIDGenerator()
+		}
+		ctx := mcontext.NewCtx(operationID)
+		if err := d.replay(ctx, producer, deadLetter); err != nil {
+			return err
+		}
+		log.ZInfo(ctx, "dead letter replayed", "stage", d.stage, "conversationID", deadLetter.ConversationID,
+			"len", len(deadLetter.MsgData), "offset", msg.Offset)
+		return nil
+	})
+}
+
+func (d *DeadLetterTool) replay(ctx context.Context, producer mq.Producer, deadLetter *pbMsg.DeadLetterMsg) error {
+	switch d.stage {
+	case constant.DeadLetterStageMsgToRedis:
+		// seq在重新写入redis时重新分配
+		for _, msgData := range deadLetter.MsgData {
+			msgData.Seq = 0
+			if _, _, err := producer.SendMessage(ctx, deadLetter.Key, msgData); err != nil {
+				return err
+			}
+		}
+	case constant.DeadLetterStageMsgToMongo, constant.DeadLetterStageMsgToSearch:
+		if _, _, err := producer.SendMessage(ctx, deadLetter.Key, &pbMsg.MsgDataToMongoByMQ{
+			LastSeq:        deadLetter.LastSeq,
+			ConversationID: deadLetter.ConversationID,
+			MsgData:        deadLetter.MsgData,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Discard 丢弃死信(只提交offset), 返回丢弃的批次数.
+func (d *DeadLetterTool) Discard(limit int) (int, error) {
+	return d.reader.Range(limit, true, func(msg *sarama.ConsumerMessage) error {
+		log.ZInfo(context.Background(), "dead letter discarded", "stage", d.stage, "partition", msg.Partition, "offset", msg.Offset)
+		return nil
+	})
+}
+
+func unmarshalDeadLetter(msg *sarama.ConsumerMessage) (*pbMsg.DeadLetterMsg, error) {
+	var deadLetter pbMsg.DeadLetterMsg
+	if err := proto.Unmarshal(msg.Value, &deadLetter); err != nil {
+		return nil, utils.Wrap(err, "unmarshal dead letter")
+	}
+	return &deadLetter, nil
+}
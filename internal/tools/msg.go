@@ -46,10 +46,14 @@ type MsgTool struct {
 	userDatabase          controller.UserDatabase
 	groupDatabase         controller.GroupDatabase
 	msgNotificationSender *notification.MsgNotificationSender
+	scheduledMsgDatabase  controller.ScheduledMsgDatabase
+	retentionDatabase     controller.RetentionPolicyDatabase
+	msgRpcClient          *rpcclient.MessageRpcClient
 }
 
 func NewMsgTool(msgDatabase controller.CommonMsgDatabase, userDatabase controller.UserDatabase,
 	groupDatabase controller.GroupDatabase, conversationDatabase controller.ConversationDatabase, msgNotificationSender *notification.MsgNotificationSender,
+	scheduledMsgDatabase controller.ScheduledMsgDatabase, retentionDatabase controller.RetentionPolicyDatabase, msgRpcClient *rpcclient.MessageRpcClient,
 ) *MsgTool {
 	return &MsgTool{
 		msgDatabase:           msgDatabase,
@@ -57,6 +61,9 @@ func NewMsgTool(msgDatabase controller.CommonMsgDatabase, userDatabase controlle
 		groupDatabase:         groupDatabase,
 		conversationDatabase:  conversationDatabase,
 		msgNotificationSender: msgNotificationSender,
+		scheduledMsgDatabase:  scheduledMsgDatabase,
+		retentionDatabase:     retentionDatabase,
+		msgRpcClient:          msgRpcClient,
 	}
 }
 
@@ -81,7 +88,10 @@ func InitMsgTool() (*MsgTool, error) {
 	}
 	discov.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	userDB := relation.NewUserGorm(db)
-	msgDatabase := controller.InitCommonMsgDatabase(rdb, mongo.GetDatabase())
+	msgDatabase, err := controller.InitCommonMsgDatabase(rdb, mongo.GetDatabase())
+	if err != nil {
+		return nil, err
+	}
 	userDatabase := controller.NewUserDatabase(
 		userDB,
 		cache.NewUserCacheRedis(rdb, relation.NewUserGorm(db), cache.GetDefaultOpt()),
@@ -95,7 +105,9 @@ func InitMsgTool() (*MsgTool, error) {
 	)
 	msgRpcClient := rpcclient.NewMessageRpcClient(discov)
 	msgNotificationSender := notification.NewMsgNotificationSender(rpcclient.WithRpcClient(&msgRpcClient))
-	msgTool := NewMsgTool(msgDatabase, userDatabase, groupDatabase, conversationDatabase, msgNotificationSender)
+	scheduledMsgDatabase := controller.NewScheduledMsgDatabase(unrelation.NewScheduledMsgMongoDriver(mongo.GetDatabase()))
+	retentionDatabase := controller.NewRetentionPolicyDatabase(unrelation.NewRetentionPolicyMongoDriver(mongo.GetDatabase()))
+	msgTool := NewMsgTool(msgDatabase, userDatabase, groupDatabase, conversationDatabase, msgNotificationSender, scheduledMsgDatabase, retentionDatabase, &msgRpcClient)
 	return msgTool, nil
 }
 
@@ -110,6 +122,17 @@ func (c *MsgTool) AllConversationClearMsgAndFixSeq() {
 	for _, conversationID := range conversationIDs {
 		conversationIDs = append(conversationIDs, utils.GetNotificationConversationIDByConversationID(conversationID))
 	}
+	// 保留策略加载失败时不做任何删除, 避免清理法律保全的会话
+	rules, err := c.getRetentionRules(ctx)
+	if err != nil {
+		log.ZError(ctx, "getRetentionRules failed", err)
+		return
+	}
+	c.ClearConversationsMsgByRetention(ctx, rules, config.Config.RetentionPolicyDryRun)
+	conversationIDs = utils.Filter(conversationIDs, func(conversationID string) (string, bool) {
+		_, ok := rules[conversationID]
+		return conversationID, !ok
+	})
 	c.ClearConversationsMsg(ctx, conversationIDs)
 	log.ZInfo(ctx, "============================ start del cron finished ============================")
 }
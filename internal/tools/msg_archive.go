@@ -0,0 +1,43 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const defaultMsgArchiveBatchSize = 1000
+
+// ArchiveMsgDocs 将超过msgArchive.afterDays的已写满文档归档到对象存储.
+func (c *MsgTool) ArchiveMsgDocs() {
+	ctx := mcontext.NewCtx(utils.GetSelfFuncName())
+	before := time.Now().AddDate(0, 0, -config.Config.MsgArchive.AfterDays)
+	limit := config.Config.MsgArchive.BatchSize
+	if limit <= 0 {
+		limit = defaultMsgArchiveBatchSize
+	}
+	log.ZInfo(ctx, "start archive msg docs", "before", before, "limit", limit)
+	n, err := c.msgDatabase.ArchiveMsgDocs(ctx, before, limit)
+	if err != nil {
+		log.ZError(ctx, "ArchiveMsgDocs failed", err)
+		return
+	}
+	log.ZInfo(ctx, "archive msg docs finished", "archived", n)
+}
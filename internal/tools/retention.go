@@ -0,0 +1,130 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"sort"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+// retentionRule 会话最终生效的保留规则.
+type retentionRule struct {
+	remainTime int64
+	maxMsgNum  int64
+	legalHold  bool
+}
+
+func newRetentionRule(policy *unRelationTb.RetentionPolicyModel) *retentionRule {
+	rule := &retentionRule{remainTime: int64(config.Config.RetainChatRecords * 24 * 60 * 60)}
+	if policy == nil {
+		return rule
+	}
+	if policy.MaxAge > 0 {
+		rule.remainTime = policy.MaxAge
+	}
+	rule.maxMsgNum = policy.MaxMsgNum
+	rule.legalHold = policy.LegalHold
+	return rule
+}
+
+// mergeRetentionRules 单聊会话由两端用户共享, 取保留消息更多的规则, 没有策略的一端按全局配置.
+func mergeRetentionRules(a, b *retentionRule) *retentionRule {
+	rule := &retentionRule{remainTime: a.remainTime, maxMsgNum: a.maxMsgNum, legalHold: a.legalHold || b.legalHold}
+	if b.remainTime > rule.remainTime {
+		rule.remainTime = b.remainTime
+	}
+	if a.maxMsgNum == 0 || b.maxMsgNum == 0 {
+		rule.maxMsgNum = 0
+	} else if b.maxMsgNum > rule.maxMsgNum {
+		rule.maxMsgNum = b.maxMsgNum
+	}
+	return rule
+}
+
+// getRetentionRules returns the rules of the conversations which have retention policies, key is conversationID.
+func (c *MsgTool) getRetentionRules(ctx context.Context) (map[string]*retentionRule, error) {
+	policies, err := c.retentionDatabase.GetAllRetentionPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules := make(map[string]*retentionRule)
+	setRule := func(conversationID string, rule *retentionRule) {
+		rules[conversationID] = rule
+		rules[utils.GetNotificationConversationIDByConversationID(conversationID)] = rule
+	}
+	userPolicies := make(map[string]*unRelationTb.RetentionPolicyModel)
+	for _, policy := range policies {
+		switch policy.TargetType {
+		case constant.RetentionTargetGroup:
+			setRule(utils.GenGroupConversationID(policy.TargetID), newRetentionRule(policy))
+		case constant.RetentionTargetUser:
+			userPolicies[policy.TargetID] = policy
+		}
+	}
+	for userID, policy := range userPolicies {
+		conversations, err := c.conversationDatabase.GetUserAllConversation(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, conversation := range conversations {
+			if conversation.ConversationType != constant.SingleChatType {
+				continue
+			}
+			if _, ok := rules[conversation.ConversationID]; ok {
+				continue
+			}
+			setRule(conversation.ConversationID, mergeRetentionRules(newRetentionRule(policy), newRetentionRule(userPolicies[conversation.UserID])))
+		}
+	}
+	return rules, nil
+}
+
+// ClearConversationsMsgByRetention 按保留策略清理会话消息, dryRun时只输出待删除的报告.
+func (c *MsgTool) ClearConversationsMsgByRetention(ctx context.Context, rules map[string]*retentionRule, dryRun bool) {
+	conversationIDs := make([]string, 0, len(rules))
+	for conversationID := range rules {
+		conversationIDs = append(conversationIDs, conversationID)
+	}
+	sort.Strings(conversationIDs)
+	var totalDelMsgNum int64
+	for _, conversationID := range conversationIDs {
+		rule := rules[conversationID]
+		if rule.legalHold {
+			log.ZInfo(ctx, "retention legal hold, skip", "conversationID", conversationID)
+			continue
+		}
+		delMsgNum, minSeq, err := c.msgDatabase.DeleteConversationMsgsByRetention(ctx, conversationID, rule.remainTime, rule.maxMsgNum, dryRun)
+		if err != nil {
+			log.ZError(ctx, "DeleteConversationMsgsByRetention failed", err, "conversationID", conversationID, "remainTime", rule.remainTime, "maxMsgNum", rule.maxMsgNum)
+			continue
+		}
+		totalDelMsgNum += delMsgNum
+		log.ZInfo(ctx, "retention report", "conversationID", conversationID, "dryRun", dryRun, "remainTime", rule.remainTime,
+			"maxMsgNum", rule.maxMsgNum, "delMsgNum", delMsgNum, "minSeq", minSeq)
+		if dryRun {
+			continue
+		}
+		if err := c.checkMaxSeq(ctx, conversationID); err != nil {
+			log.ZError(ctx, "fixSeq failed", err, "conversationID", conversationID)
+		}
+	}
+	log.ZInfo(ctx, "retention finished", "dryRun", dryRun, "conversationNum", len(conversationIDs), "delMsgNum", totalDelMsgNum)
+}
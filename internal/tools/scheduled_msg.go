@@ -0,0 +1,87 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/msg"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+// DispatchScheduledMsgs sends every due scheduled msg through msg rpc SendMsg.
+func (c *MsgTool) DispatchScheduledMsgs() {
+	ctx := mcontext.NewCtx(utils.GetSelfFuncName())
+	for {
+		scheduledMsg, err := c.scheduledMsgDatabase.TakeDueScheduledMsg(ctx, time.Now().UnixMilli())
+		if err != nil {
+			log.ZError(ctx, "TakeDueScheduledMsg failed", err)
+			return
+		}
+		if scheduledMsg == nil {
+			return
+		}
+		c.dispatchScheduledMsg(scheduledMsg)
+	}
+}
+
+func (c *MsgTool) dispatchScheduledMsg(scheduledMsg *unRelationTb.ScheduledMsgModel) {
+	ctx := mcontext.NewCtx(utils.GetSelfFuncName() + "-" + utils.OperationIDGenerator() + "-" + scheduledMsg.ScheduledMsgID)
+	serverMsgID, sendErr := c.sendScheduledMsg(ctx, scheduledMsg)
+	if sendErr != nil {
+		log.ZError(ctx, "send scheduled msg failed", sendErr, "scheduledMsgID", scheduledMsg.ScheduledMsgID, "sendID", scheduledMsg.SendID,
+			"attempts", scheduledMsg.Attempts)
+	} else {
+		log.ZInfo(ctx, "send scheduled msg success", "scheduledMsgID", scheduledMsg.ScheduledMsgID, "serverMsgID", serverMsgID)
+	}
+	retry := sendErr != nil && isRetryableSendErr(sendErr)
+	if err := c.scheduledMsgDatabase.SetScheduledMsgResult(ctx, scheduledMsg, serverMsgID, sendErr, retry); err != nil {
+		log.ZError(ctx, "SetScheduledMsgResult failed", err, "scheduledMsgID", scheduledMsg.ScheduledMsgID)
+	}
+}
+
+func (c *MsgTool) sendScheduledMsg(ctx context.Context, scheduledMsg *unRelationTb.ScheduledMsgModel) (string, error) {
+	var msgData sdkws.MsgData
+	if err := proto.Unmarshal(scheduledMsg.MsgData, &msgData); err != nil {
+		return "", errs.ErrData.Wrap(err.Error())
+	}
+	resp, err := c.msgRpcClient.SendMsg(ctx, &msg.SendMsgReq{MsgData: &msgData})
+	if err != nil {
+		return "", err
+	}
+	return resp.ServerMsgID, nil
+}
+
+// isRetryableSendErr rpc不可用、超时和db错误等可以重试, 业务错误(如不在群中)直接失败.
+func isRetryableSendErr(err error) bool {
+	codeErr, ok := errs.Unwrap(err).(errs.CodeError)
+	if !ok {
+		return true
+	}
+	switch codeErr.Code() {
+	case errs.DatabaseError, errs.NetworkError, errs.MsgSending, errs.RateLimitExceeded:
+		return true
+	}
+	// grpc状态码和ServerInternalError
+	return codeErr.Code() < errs.UnknownCode
+}
@@ -30,6 +30,7 @@ type SendMsg struct {
 	IsOnlineOnly     bool                   `json:"isOnlineOnly"`
 	NotOfflinePush   bool                   `json:"notOfflinePush"`
 	OfflinePushInfo  *sdkws.OfflinePushInfo `json:"offlinePushInfo"`
+	SendTime         int64                  `json:"sendTime"` // 大于当前时间(毫秒)时为定时消息
 }
 
 type SendMsgReq struct {
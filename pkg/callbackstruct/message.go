@@ -79,3 +79,61 @@ type CallbackMsgModifyCommandResp struct {
 	AttachedInfo     *string                `json:"attachedInfo"`
 	Ex               *string                `json:"ex"`
 }
+
+type CallbackBeforeSetMessageReactionExtReq struct {
+	OperationID           string `json:"operationID"`
+	CallbackCommand       `json:"callbackCommand"`
+	ConversationID        string                     `json:"conversationID"`
+	OpUserID              string                     `json:"opUserID"`
+	SessionType           int32                      `json:"sessionType"`
+	ClientMsgID           string                     `json:"clientMsgID"`
+	Seq                   int64                      `json:"seq"`
+	ReactionExtensionList map[string]*sdkws.KeyValue `json:"reactionExtensionList"`
+}
+
+type CallbackBeforeSetMessageReactionExtResp struct {
+	CommonCallbackResp
+}
+
+type CallbackDeleteMessageReactionExtReq struct {
+	OperationID     string `json:"operationID"`
+	CallbackCommand `json:"callbackCommand"`
+	ConversationID  string   `json:"conversationID"`
+	OpUserID        string   `json:"opUserID"`
+	SessionType     int32    `json:"sessionType"`
+	ClientMsgID     string   `json:"clientMsgID"`
+	Seq             int64    `json:"seq"`
+	TypeKeys        []string `json:"typeKeys"`
+}
+
+type CallbackDeleteMessageReactionExtResp struct {
+	CommonCallbackResp
+}
+
+type CallbackGetMessageListReactionExtReq struct {
+	OperationID     string `json:"operationID"`
+	CallbackCommand `json:"callbackCommand"`
+	ConversationID  string   `json:"conversationID"`
+	OpUserID        string   `json:"opUserID"`
+	Seqs            []int64  `json:"seqs"`
+	TypeKeys        []string `json:"typeKeys"`
+}
+
+type CallbackGetMessageListReactionExtResp struct {
+	CommonCallbackResp
+}
+
+type CallbackAddMessageReactionExtReq struct {
+	OperationID           string `json:"operationID"`
+	CallbackCommand       `json:"callbackCommand"`
+	ConversationID        string                     `json:"conversationID"`
+	OpUserID              string                     `json:"opUserID"`
+	SessionType           int32                      `json:"sessionType"`
+	ClientMsgID           string                     `json:"clientMsgID"`
+	Seq                   int64                      `json:"seq"`
+	ReactionExtensionList map[string]*sdkws.KeyValue `json:"reactionExtensionList"`
+}
+
+type CallbackAddMessageReactionExtResp struct {
+	CommonCallbackResp
+}
@@ -15,9 +15,14 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/OpenIMSDK/Open-IM-Server/internal/tools"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
 )
 
 type MsgUtilsCmd struct {
@@ -79,6 +84,24 @@ func (m *MsgUtilsCmd) getLimitFlag(cmdLines *cobra.Command) int64 {
 	return limit
 }
 
+func (m *MsgUtilsCmd) AddStageFlag() {
+	m.Command.PersistentFlags().StringP("stage", "s", "", "msgtransfer dead letter stage: msgToRedis, msgToMongo or msgToSearch")
+}
+
+func (m *MsgUtilsCmd) getStageFlag(cmdLines *cobra.Command) string {
+	stage, _ := cmdLines.Flags().GetString("stage")
+	return stage
+}
+
+func (m *MsgUtilsCmd) AddConfFlag() {
+	m.Command.PersistentFlags().String(constant.FlagConf, "", "Path to config file folder")
+}
+
+func (m *MsgUtilsCmd) initConfig(cmdLines *cobra.Command) error {
+	configFolderPath, _ := cmdLines.Flags().GetString(constant.FlagConf)
+	return config.InitConfig(configFolderPath)
+}
+
 func (m *MsgUtilsCmd) Execute() error {
 	return m.Command.Execute()
 }
@@ -123,6 +146,36 @@ func NewClearCmd() *ClearCmd {
 	}
 }
 
+type InspectCmd struct {
+	*MsgUtilsCmd
+}
+
+func NewInspectCmd() *InspectCmd {
+	return &InspectCmd{
+		NewMsgUtilsCmd("inspect [resource]", "inspect action", cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs)),
+	}
+}
+
+type ReplayCmd struct {
+	*MsgUtilsCmd
+}
+
+func NewReplayCmd() *ReplayCmd {
+	return &ReplayCmd{
+		NewMsgUtilsCmd("replay [resource]", "replay action", cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs)),
+	}
+}
+
+type DiscardCmd struct {
+	*MsgUtilsCmd
+}
+
+func NewDiscardCmd() *DiscardCmd {
+	return &DiscardCmd{
+		NewMsgUtilsCmd("discard [resource]", "discard action", cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs)),
+	}
+}
+
 type SeqCmd struct {
 	*MsgUtilsCmd
 }
@@ -183,3 +236,66 @@ func (m *MsgCmd) GetMsgCmd() *cobra.Command {
 func (m *MsgCmd) ClearMsgCmd() *cobra.Command {
 	return &m.Command
 }
+
+type DeadLetterCmd struct {
+	*MsgUtilsCmd
+}
+
+func NewDeadLetterCmd() *DeadLetterCmd {
+	return &DeadLetterCmd{
+		NewMsgUtilsCmd("deadLetter", "msgtransfer dead letter batches", nil),
+	}
+}
+
+func (d *DeadLetterCmd) initTool(cmdLines *cobra.Command) *tools.DeadLetterTool {
+	if err := d.initConfig(cmdLines); err != nil {
+		panic(err)
+	}
+	deadLetterTool, err := tools.InitDeadLetterTool(d.getStageFlag(cmdLines))
+	if err != nil {
+		panic(err)
+	}
+	return deadLetterTool
+}
+
+func (d *DeadLetterCmd) InspectDeadLetterCmd() *cobra.Command {
+	d.Command.Run = func(cmdLines *cobra.Command, args []string) {
+		deadLetterTool := d.initTool(cmdLines)
+		defer deadLetterTool.Close()
+		deadLetters, err := deadLetterTool.Inspect(int(d.getLimitFlag(cmdLines)))
+		if err != nil {
+			panic(err)
+		}
+		for _, deadLetter := range deadLetters {
+			fmt.Println(utils.StructToJsonString(deadLetter))
+		}
+		fmt.Println("dead letter num:", len(deadLetters))
+	}
+	return &d.Command
+}
+
+func (d *DeadLetterCmd) ReplayDeadLetterCmd() *cobra.Command {
+	d.Command.Run = func(cmdLines *cobra.Command, args []string) {
+		deadLetterTool := d.initTool(cmdLines)
+		defer deadLetterTool.Close()
+		n, err := deadLetterTool.Replay(int(d.getLimitFlag(cmdLines)))
+		fmt.Println("replayed dead letter num:", n)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return &d.Command
+}
+
+func (d *DeadLetterCmd) DiscardDeadLetterCmd() *cobra.Command {
+	d.Command.Run = func(cmdLines *cobra.Command, args []string) {
+		deadLetterTool := d.initTool(cmdLines)
+		defer deadLetterTool.Close()
+		n, err := deadLetterTool.Discard(int(d.getLimitFlag(cmdLines)))
+		fmt.Println("discarded dead letter num:", n)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return &d.Command
+}
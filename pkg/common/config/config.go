@@ -78,6 +78,10 @@ type config struct {
 		Password string   `yaml:"password"`
 	} `yaml:"redis"`
 
+	MQ struct {
+		Type string `yaml:"type"`
+	} `yaml:"mq"`
+
 	Kafka struct {
 		Username         string   `yaml:"username"`
 		Password         string   `yaml:"password"`
@@ -91,11 +95,29 @@ type config struct {
 		MsgToPush struct {
 			Topic string `yaml:"topic"`
 		} `yaml:"msgToPush"`
+		MsgToRedisDeadLetter struct {
+			Topic string `yaml:"topic"`
+		} `yaml:"msgToRedisDeadLetter"`
+		MsgToMongoDeadLetter struct {
+			Topic string `yaml:"topic"`
+		} `yaml:"msgToMongoDeadLetter"`
+		MsgToSearch struct {
+			Topic string `yaml:"topic"`
+		} `yaml:"msgToSearch"`
+		MsgToSearchDeadLetter struct {
+			Topic string `yaml:"topic"`
+		} `yaml:"msgToSearchDeadLetter"`
+		DeadLetter struct {
+			MaxRetry     int `yaml:"maxRetry"`
+			RetryBackoff int `yaml:"retryBackoff"`
+		} `yaml:"deadLetter"`
 		ConsumerGroupID struct {
-			MsgToRedis string `yaml:"msgToRedis"`
-			MsgToMongo string `yaml:"msgToMongo"`
-			MsgToMySql string `yaml:"msgToMySql"`
-			MsgToPush  string `yaml:"msgToPush"`
+			MsgToRedis  string `yaml:"msgToRedis"`
+			MsgToMongo  string `yaml:"msgToMongo"`
+			MsgToSearch string `yaml:"msgToSearch"`
+			MsgToMySql  string `yaml:"msgToMySql"`
+			MsgToPush   string `yaml:"msgToPush"`
+			DeadLetter  string `yaml:"deadLetter"`
 		} `yaml:"consumerGroupID"`
 	} `yaml:"kafka"`
 
@@ -133,8 +155,20 @@ type config struct {
 			AccessKeySecret string `yaml:"accessKeySecret"`
 			SessionToken    string `yaml:"sessionToken"`
 		} `yaml:"oss"`
+		Local struct {
+			Dir      string `yaml:"dir"`
+			Endpoint string `yaml:"endpoint"`
+		} `yaml:"local"`
 	} `yaml:"object"`
 
+	MsgSearch struct {
+		Enable string `yaml:"enable"`
+		Local  struct {
+			Dir     string `yaml:"dir"`
+			MaxSize int    `yaml:"maxSize"`
+		} `yaml:"local"`
+	} `yaml:"msgSearch"`
+
 	RpcPort struct {
 		OpenImUserPort           []int `yaml:"openImUserPort"`
 		OpenImFriendPort         []int `yaml:"openImFriendPort"`
@@ -158,6 +192,7 @@ type config struct {
 		OpenImAuthName           string `yaml:"openImAuthName"`
 		OpenImConversationName   string `yaml:"openImConversationName"`
 		OpenImThirdName          string `yaml:"openImThirdName"`
+		OpenImRtcName            string `yaml:"openImRtcName"`
 	} `yaml:"rpcRegisterName"`
 
 	Log struct {
@@ -175,8 +210,39 @@ type config struct {
 		WebsocketMaxConnNum int   `yaml:"websocketMaxConnNum"`
 		WebsocketMaxMsgLen  int   `yaml:"websocketMaxMsgLen"`
 		WebsocketTimeout    int   `yaml:"websocketTimeout"`
+		ResumeWindow        int   `yaml:"resumeWindow"`
+		ReconnectBackoff    int   `yaml:"reconnectBackoff"`
+		RateLimit           struct {
+			Enable    bool `yaml:"enable"`
+			ConnRate  int  `yaml:"connRate"`
+			ConnBurst int  `yaml:"connBurst"`
+			UserRate  int  `yaml:"userRate"`
+			UserBurst int  `yaml:"userBurst"`
+			ReqLimits []struct {
+				ReqIdentifier int32 `yaml:"reqIdentifier"`
+				Rate          int   `yaml:"rate"`
+				Burst         int   `yaml:"burst"`
+			} `yaml:"reqLimits"`
+			ConnectRate     int `yaml:"connectRate"`
+			ConnectBurst    int `yaml:"connectBurst"`
+			MaxViolations   int `yaml:"maxViolations"`
+			ViolationWindow int `yaml:"violationWindow"`
+			BanTime         int `yaml:"banTime"`
+		} `yaml:"rateLimit"`
 	} `yaml:"longConnSvr"`
 
+	Shutdown struct {
+		DrainTimeout int `yaml:"drainTimeout"`
+	} `yaml:"shutdown"`
+
+	Rtc struct {
+		SignalTimeout int `yaml:"signalTimeout"`
+	} `yaml:"rtc"`
+
+	Ephemeral struct {
+		MaxPerSecond int `yaml:"maxPerSecond"`
+	} `yaml:"ephemeral"`
+
 	Push struct {
 		Enable string `yaml:"enable"`
 		GeTui  struct {
@@ -205,15 +271,25 @@ type config struct {
 	MultiLoginPolicy                  int    `yaml:"multiLoginPolicy"`
 	ChatPersistenceMysql              bool   `yaml:"chatPersistenceMysql"`
 	MsgCacheTimeout                   int    `yaml:"msgCacheTimeout"`
+	MsgDedupWindow                    int    `yaml:"msgDedupWindow"`
 	GroupMessageHasReadReceiptEnable  bool   `yaml:"groupMessageHasReadReceiptEnable"`
 	SingleMessageHasReadReceiptEnable bool   `yaml:"singleMessageHasReadReceiptEnable"`
 	RetainChatRecords                 int    `yaml:"retainChatRecords"`
 	ChatRecordsClearTime              string `yaml:"chatRecordsClearTime"`
+	RetentionPolicyDryRun             bool   `yaml:"retentionPolicyDryRun"`
 	MsgDestructTime                   string `yaml:"msgDestructTime"`
+	ScheduledMsgDispatchTime          string `yaml:"scheduledMsgDispatchTime"`
 	Secret                            string `yaml:"secret"`
 	TokenPolicy                       struct {
 		Expire int64 `yaml:"expire"`
 	} `yaml:"tokenPolicy"`
+	MsgArchive struct {
+		Enable      bool   `yaml:"enable"`
+		ArchiveTime string `yaml:"archiveTime"`
+		AfterDays   int    `yaml:"afterDays"`
+		BatchSize   int    `yaml:"batchSize"`
+		CacheSize   int    `yaml:"cacheSize"`
+	} `yaml:"msgArchive"`
 	MessageVerify struct {
 		FriendVerify *bool `yaml:"friendVerify"`
 	} `yaml:"messageVerify"`
@@ -224,22 +300,23 @@ type config struct {
 		Production bool   `yaml:"production"`
 	} `yaml:"iosPush"`
 	Callback struct {
-		CallbackUrl                        string         `yaml:"url"`
-		CallbackBeforeSendSingleMsg        CallBackConfig `yaml:"beforeSendSingleMsg"`
-		CallbackAfterSendSingleMsg         CallBackConfig `yaml:"afterSendSingleMsg"`
-		CallbackBeforeSendGroupMsg         CallBackConfig `yaml:"beforeSendGroupMsg"`
-		CallbackAfterSendGroupMsg          CallBackConfig `yaml:"afterSendGroupMsg"`
-		CallbackMsgModify                  CallBackConfig `yaml:"msgModify"`
-		CallbackUserOnline                 CallBackConfig `yaml:"userOnline"`
-		CallbackUserOffline                CallBackConfig `yaml:"userOffline"`
-		CallbackUserKickOff                CallBackConfig `yaml:"userKickOff"`
-		CallbackOfflinePush                CallBackConfig `yaml:"offlinePush"`
-		CallbackOnlinePush                 CallBackConfig `yaml:"onlinePush"`
-		CallbackBeforeSuperGroupOnlinePush CallBackConfig `yaml:"superGroupOnlinePush"`
-		CallbackBeforeAddFriend            CallBackConfig `yaml:"beforeAddFriend"`
-		CallbackBeforeCreateGroup          CallBackConfig `yaml:"beforeCreateGroup"`
-		CallbackBeforeMemberJoinGroup      CallBackConfig `yaml:"beforeMemberJoinGroup"`
-		CallbackBeforeSetGroupMemberInfo   CallBackConfig `yaml:"beforeSetGroupMemberInfo"`
+		CallbackUrl                          string         `yaml:"url"`
+		CallbackBeforeSendSingleMsg          CallBackConfig `yaml:"beforeSendSingleMsg"`
+		CallbackAfterSendSingleMsg           CallBackConfig `yaml:"afterSendSingleMsg"`
+		CallbackBeforeSendGroupMsg           CallBackConfig `yaml:"beforeSendGroupMsg"`
+		CallbackAfterSendGroupMsg            CallBackConfig `yaml:"afterSendGroupMsg"`
+		CallbackMsgModify                    CallBackConfig `yaml:"msgModify"`
+		CallbackUserOnline                   CallBackConfig `yaml:"userOnline"`
+		CallbackUserOffline                  CallBackConfig `yaml:"userOffline"`
+		CallbackUserKickOff                  CallBackConfig `yaml:"userKickOff"`
+		CallbackOfflinePush                  CallBackConfig `yaml:"offlinePush"`
+		CallbackOnlinePush                   CallBackConfig `yaml:"onlinePush"`
+		CallbackBeforeSuperGroupOnlinePush   CallBackConfig `yaml:"superGroupOnlinePush"`
+		CallbackBeforeAddFriend              CallBackConfig `yaml:"beforeAddFriend"`
+		CallbackBeforeCreateGroup            CallBackConfig `yaml:"beforeCreateGroup"`
+		CallbackBeforeMemberJoinGroup        CallBackConfig `yaml:"beforeMemberJoinGroup"`
+		CallbackBeforeSetGroupMemberInfo     CallBackConfig `yaml:"beforeSetGroupMemberInfo"`
+		CallbackSetMessageReactionExtensions CallBackConfig `yaml:"setMessageReactionExtensions"`
 	} `yaml:"callback"`
 
 	Prometheus struct {
@@ -308,5 +385,6 @@ func GetServiceNames() []string {
 		Config.RpcRegisterName.OpenImAuthName,
 		Config.RpcRegisterName.OpenImConversationName,
 		Config.RpcRegisterName.OpenImThirdName,
+		Config.RpcRegisterName.OpenImRtcName,
 	}
 }
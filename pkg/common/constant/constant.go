@@ -108,6 +108,8 @@ const (
 
 	ClearConversationNotification = 2101
 	DeleteMsgsNotification        = 2102
+	MsgEditNotification           = 2103
+	MsgPinnedNotification         = 2104
 
 	HasReadReceipt = 2200
 
@@ -169,6 +171,7 @@ const (
 	IsReactionFromCache        = "reactionFromCache"
 	IsNotNotification          = "isNotNotification"
 	IsSendMsg                  = "isSendMsg"
+	IsDeliveryReceipt          = "deliveryReceipt"
 
 	// GroupStatus.
 	GroupOk              = 0
@@ -236,6 +239,66 @@ const (
 	MsgSendFailed     = 3
 )
 
+const (
+	// scheduledMsgStatus.
+	ScheduledMsgPending  = 1
+	ScheduledMsgSending  = 2
+	ScheduledMsgSent     = 3
+	ScheduledMsgCanceled = 4
+	ScheduledMsgFailed   = 5
+)
+
+const (
+	// msgDeliveryState.
+	MsgDeliveryAccepted      = 1
+	MsgDeliveryPersisted     = 2
+	MsgDeliveryPushedOnline  = 3
+	MsgDeliveryPushedOffline = 4
+	MsgDeliveryDelivered     = 5
+
+	// 超过该人数的群不跟踪消息投递状态
+	MsgDeliveryMaxGroupMemberNum = 500
+)
+
+const (
+	// retentionTargetType.
+	RetentionTargetGroup = 1
+	RetentionTargetUser  = 2
+)
+
+const (
+	// signalState 音视频通话参与者状态.
+	SignalStateInvited  = 1
+	SignalStateJoined   = 2
+	SignalStateRejected = 3
+	SignalStateHungUp   = 4
+	SignalStateTimeout  = 5
+	SignalStateCanceled = 6
+)
+
+const (
+	// presenceStatus 用户是否有在线的连接.
+	PresenceOffline = 0
+	PresenceOnline  = 1
+
+	// presenceCustomState 用户自己设置的状态.
+	PresenceCustomStateDefault = 0
+	PresenceCustomStateAway    = 1
+
+	PresenceStatusTextMaxLength = 64
+	// 单次查询/订阅在线状态的最大用户数
+	PresenceMaxUserIDs = 100
+)
+
+const (
+	// ephemeralEventType 临时事件类型, 业务可以使用更大的值自定义.
+	EphemeralTyping    = 1
+	EphemeralRecording = 2
+	EphemeralCursor    = 3
+
+	EphemeralContentMaxLength = 1024
+)
+
 const (
 	WriteDiffusion = 0
 	ReadDiffusion  = 1
@@ -361,3 +424,10 @@ const OpenIMCommonConfigKey = "OpenIMServerConfig"
 const CallbackCommand = "command"
 
 const BatchNum = 100
+
+// msgtransfer死信阶段.
+const (
+	DeadLetterStageMsgToRedis  = "msgToRedis"
+	DeadLetterStageMsgToMongo  = "msgToMongo"
+	DeadLetterStageMsgToSearch = "msgToSearch"
+)
@@ -53,6 +53,7 @@ func MsgPb2DB(msg *sdkws.MsgData) *unrelation.MsgDataModel {
 	msgDataModel.AtUserIDList = msg.AtUserIDList
 	msgDataModel.AttachedInfo = msg.AttachedInfo
 	msgDataModel.Ex = msg.Ex
+	msgDataModel.ThreadRootSeq = msg.ThreadRootSeq
 	return &msgDataModel
 }
 
@@ -90,5 +91,6 @@ func MsgDB2Pb(msgModel *unrelation.MsgDataModel) *sdkws.MsgData {
 	msg.AtUserIDList = msgModel.AtUserIDList
 	msg.AttachedInfo = msgModel.AttachedInfo
 	msg.Ex = msgModel.Ex
+	msg.ThreadRootSeq = msgModel.ThreadRootSeq
 	return &msg
 }
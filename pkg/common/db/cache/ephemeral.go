@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
+
+const ephemeralSendCount = "EPHEMERAL_SEND_COUNT:"
+
+// ephemeralCache 按秒统计用户发送的临时事件数量, 用于限流.
+type ephemeralCache interface {
+	IncrEphemeralSendCount(ctx context.Context, userID string) (int64, error)
+}
+
+func (c *msgCache) getEphemeralSendCountKey(userID string, second int64) string {
+	return ephemeralSendCount + userID + ":" + strconv.FormatInt(second, 10)
+}
+
+func (c *msgCache) IncrEphemeralSendCount(ctx context.Context, userID string) (int64, error) {
+	key := c.getEphemeralSendCountKey(userID, time.Now().Unix())
+	pipe := c.rdb.Pipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, time.Second*2)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return incr.Val(), nil
+}
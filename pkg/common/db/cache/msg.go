@@ -17,6 +17,7 @@ package cache
 import (
 	"context"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dtm-labs/rockscache"
@@ -55,6 +56,12 @@ const (
 	userBadgeUnreadCountSum = "USER_BADGE_UNREAD_COUNT_SUM:"
 	exTypeKeyLocker         = "EX_LOCK:"
 	uidPidToken             = "UID_PID_TOKEN_STATUS:"
+	msgDeliveryStatus       = "MSG_DELIVERY_STATUS:"
+
+	msgDeliveryStatusExpire = time.Hour * 24 * 3
+	msgDeliverySendIDField  = "sendID"
+	msgDeliveryRecvIDField  = "recvID"
+	msgDeliveryGroupIDField = "groupID"
 )
 
 type SeqCache interface {
@@ -80,6 +87,8 @@ type SeqCache interface {
 	UserSetHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]int64) error
 	GetHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	GetHasReadSeq(ctx context.Context, userID string, conversationID string) (int64, error)
+	// k: user, v: seq
+	GetConversationUsersHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
 }
 
 type thirdCache interface {
@@ -95,6 +104,25 @@ type thirdCache interface {
 	GetGetuiTaskID(ctx context.Context) (string, error)
 }
 
+// MsgDeliveryTarget 消息的发送者和接收者, 单聊RecvID不为空, 群聊GroupID不为空.
+type MsgDeliveryTarget struct {
+	SendID  string
+	RecvID  string
+	GroupID string
+}
+
+// msgDeliveryCache 记录消息在各环节的投递状态, userID为空表示消息级别的状态.
+type msgDeliveryCache interface {
+	InitMsgDeliveryStatus(ctx context.Context, serverMsgID string, target *MsgDeliveryTarget, acceptTime int64) error
+	// GetMsgsDeliveryTargets 只返回跟踪了投递状态的消息, k: serverMsgID
+	GetMsgsDeliveryTargets(ctx context.Context, serverMsgIDs []string) (map[string]*MsgDeliveryTarget, error)
+	SetMsgsDeliveryState(ctx context.Context, serverMsgIDs []string, userID string, state int32, stateTime int64) error
+	SetMsgDeliveryUsersState(ctx context.Context, serverMsgID string, userIDs []string, state int32, stateTime int64) error
+	AckMsgsDelivered(ctx context.Context, userID string, serverMsgIDs []string, ackTime int64) error
+	// states k: userID, v: state -> time
+	GetMsgDeliveryStatus(ctx context.Context, serverMsgID string) (sendID string, states map[string]map[int32]int64, err error)
+}
+
 type MsgModel interface {
 	SeqCache
 	thirdCache
@@ -116,6 +144,14 @@ type MsgModel interface {
 	DelMsgFromCache(ctx context.Context, userID string, seqList []int64) error
 	SetSendMsgStatus(ctx context.Context, id string, status int32) error
 	GetSendMsgStatus(ctx context.Context, id string) (int32, error)
+	msgDeliveryCache
+	onlineRouteCache
+	signalingCache
+	presenceCache
+	ephemeralCache
+	resumeSessionCache
+	rateLimitCache
+	msgDedupCache
 	JudgeMessageReactionExist(ctx context.Context, clientMsgID string, sessionType int32) (bool, error)
 	GetOneMessageAllReactionList(ctx context.Context, clientMsgID string, sessionType int32) (map[string]string, error)
 	DeleteOneMessageKey(ctx context.Context, clientMsgID string, sessionType int32, subKey string) error
@@ -314,6 +350,16 @@ func (c *msgCache) GetHasReadSeq(ctx context.Context, userID string, conversatio
 	return utils.Wrap2(c.rdb.Get(ctx, c.getHasReadSeqKey(conversationID, userID)).Int64())
 }
 
+func (c *msgCache) GetConversationUsersHasReadSeqs(
+	ctx context.Context,
+	conversationID string,
+	userIDs []string,
+) (map[string]int64, error) {
+	return c.getSeqs(ctx, userIDs, func(userID string) string {
+		return c.getHasReadSeqKey(conversationID, userID)
+	})
+}
+
 func (c *msgCache) AddTokenFlag(ctx context.Context, userID string, platformID int, token string, flag int) error {
 	key := uidPidToken + userID + ":" + constant.PlatformIDToName(platformID)
 	return errs.Wrap(c.rdb.HSet(ctx, key, token, flag).Err())
@@ -571,6 +617,140 @@ func (c *msgCache) GetSendMsgStatus(ctx context.Context, id string) (int32, erro
 	return int32(result), errs.Wrap(err)
 }
 
+// setMsgDeliveryStateScript 只更新InitMsgDeliveryStatus创建过的投递状态, 不跟踪的消息不会创建key.
+// KEYS[1]投递状态, ARGV[1] sendID字段, ARGV[2]过期秒数, ARGV[3]状态时间, ARGV[4...]状态字段, 只保留第一次到达该状态的时间.
+var setMsgDeliveryStateScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+for i = 4, #ARGV do
+	redis.call('HSETNX', KEYS[1], ARGV[i], ARGV[3])
+end
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return 1
+`)
+
+func (c *msgCache) getMsgDeliveryStatusKey(serverMsgID string) string {
+	return msgDeliveryStatus + serverMsgID
+}
+
+func (c *msgCache) getMsgDeliveryStateField(userID string, state int32) string {
+	return userID + ":" + strconv.Itoa(int(state))
+}
+
+func (c *msgCache) InitMsgDeliveryStatus(ctx context.Context, serverMsgID string, target *MsgDeliveryTarget, acceptTime int64) error {
+	key := c.getMsgDeliveryStatusKey(serverMsgID)
+	pipe := c.rdb.Pipeline()
+	pipe.HSet(ctx, key,
+		msgDeliverySendIDField, target.SendID,
+		msgDeliveryRecvIDField, target.RecvID,
+		msgDeliveryGroupIDField, target.GroupID,
+		c.getMsgDeliveryStateField("", constant.MsgDeliveryAccepted), acceptTime,
+	)
+	pipe.Expire(ctx, key, msgDeliveryStatusExpire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) GetMsgsDeliveryTargets(ctx context.Context, serverMsgIDs []string) (map[string]*MsgDeliveryTarget, error) {
+	targets := make(map[string]*MsgDeliveryTarget)
+	if len(serverMsgIDs) == 0 {
+		return targets, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.SliceCmd, 0, len(serverMsgIDs))
+	for _, serverMsgID := range serverMsgIDs {
+		cmds = append(cmds, pipe.HMGet(ctx, c.getMsgDeliveryStatusKey(serverMsgID), msgDeliverySendIDField, msgDeliveryRecvIDField, msgDeliveryGroupIDField))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	for i, cmd := range cmds {
+		vals := cmd.Val()
+		if len(vals) != 3 || vals[0] == nil {
+			continue
+		}
+		target := &MsgDeliveryTarget{}
+		target.SendID, _ = vals[0].(string)
+		target.RecvID, _ = vals[1].(string)
+		target.GroupID, _ = vals[2].(string)
+		targets[serverMsgIDs[i]] = target
+	}
+	return targets, nil
+}
+
+func (c *msgCache) setMsgDeliveryState(ctx context.Context, pipe redis.Pipeliner, serverMsgID string, fields []string, stateTime int64) {
+	args := make([]interface{}, 0, 3+len(fields))
+	args = append(args, msgDeliverySendIDField, int64(msgDeliveryStatusExpire/time.Second), stateTime)
+	for _, field := range fields {
+		args = append(args, field)
+	}
+	// pipeline中EvalSha无法在NOSCRIPT时回退, 使用Eval
+	setMsgDeliveryStateScript.Eval(ctx, pipe, []string{c.getMsgDeliveryStatusKey(serverMsgID)}, args...)
+}
+
+func (c *msgCache) SetMsgsDeliveryState(ctx context.Context, serverMsgIDs []string, userID string, state int32, stateTime int64) error {
+	if len(serverMsgIDs) == 0 {
+		return nil
+	}
+	fields := []string{c.getMsgDeliveryStateField(userID, state)}
+	pipe := c.rdb.Pipeline()
+	for _, serverMsgID := range serverMsgIDs {
+		c.setMsgDeliveryState(ctx, pipe, serverMsgID, fields, stateTime)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) SetMsgDeliveryUsersState(ctx context.Context, serverMsgID string, userIDs []string, state int32, stateTime int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	fields := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		fields = append(fields, c.getMsgDeliveryStateField(userID, state))
+	}
+	pipe := c.rdb.Pipeline()
+	c.setMsgDeliveryState(ctx, pipe, serverMsgID, fields, stateTime)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) AckMsgsDelivered(ctx context.Context, userID string, serverMsgIDs []string, ackTime int64) error {
+	return c.SetMsgsDeliveryState(ctx, serverMsgIDs, userID, constant.MsgDeliveryDelivered, ackTime)
+}
+
+func (c *msgCache) GetMsgDeliveryStatus(ctx context.Context, serverMsgID string) (sendID string, states map[string]map[int32]int64, err error) {
+	fields, err := c.rdb.HGetAll(ctx, c.getMsgDeliveryStatusKey(serverMsgID)).Result()
+	if err != nil {
+		return "", nil, errs.Wrap(err)
+	}
+	if len(fields) == 0 {
+		return "", nil, errs.ErrRecordNotFound.Wrap("msg delivery status not found")
+	}
+	states = make(map[string]map[int32]int64)
+	for field, value := range fields {
+		if field == msgDeliverySendIDField {
+			sendID = value
+			continue
+		}
+		index := strings.LastIndex(field, ":")
+		if index < 0 {
+			continue
+		}
+		userID := field[:index]
+		state, err := strconv.Atoi(field[index+1:])
+		if err != nil {
+			continue
+		}
+		if states[userID] == nil {
+			states[userID] = make(map[int32]int64)
+		}
+		states[userID][int32(state)] = utils.StringToInt64(value)
+	}
+	return sendID, states, nil
+}
+
 func (c *msgCache) SetFcmToken(
 	ctx context.Context,
 	account string,
@@ -607,7 +787,14 @@ func (c *msgCache) GetUserBadgeUnreadCountSum(ctx context.Context, userID string
 
 func (c *msgCache) LockMessageTypeKey(ctx context.Context, clientMsgID string, TypeKey string) error {
 	key := exTypeKeyLocker + clientMsgID + "_" + TypeKey
-	return errs.Wrap(c.rdb.SetNX(ctx, key, 1, time.Minute).Err())
+	ok, err := c.rdb.SetNX(ctx, key, 1, time.Minute).Result()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if !ok {
+		return errs.ErrInternalServer.Wrap("message type key is locked")
+	}
+	return nil
 }
 
 func (c *msgCache) UnLockMessageTypeKey(ctx context.Context, clientMsgID string, TypeKey string) error {
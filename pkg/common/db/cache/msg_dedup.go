@@ -0,0 +1,163 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
+
+const (
+	msgDedup    = "MSG_DEDUP:"
+	msgDedupSeq = "MSG_DEDUP_SEQ:"
+)
+
+// claimClientMsgIDScript KEYS[1]首次发送的记录, KEYS[2]消息写入redis后分配的seq.
+// 记录不存在时保存ARGV[1] serverMsgID, ARGV[2] sendTime并设置ARGV[3]毫秒过期, 返回false; 否则返回已保存的记录、seq和是否已投递.
+var claimClientMsgIDScript = redis.NewScript(`
+if redis.call('HSETNX', KEYS[1], 'serverMsgID', ARGV[1]) == 1 then
+	redis.call('HSET', KEYS[1], 'sendTime', ARGV[2], 'sent', '0')
+	redis.call('PEXPIRE', KEYS[1], ARGV[3])
+	return false
+end
+local v = redis.call('HMGET', KEYS[1], 'serverMsgID', 'sendTime', 'sent')
+return {v[1], v[2], redis.call('GET', KEYS[2]), v[3]}
+`)
+
+// markClientMsgIDSentScript 记录存在时标记消息已投递到mq.
+var markClientMsgIDSentScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('HSET', KEYS[1], 'sent', '1')
+end
+return 0
+`)
+
+// releaseClientMsgIDScript 只删除还未投递到mq的记录, 已投递的消息不允许以相同clientMsgID再次发送.
+var releaseClientMsgIDScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'sent') == '0' then
+	redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// MsgDedupRecord clientMsgID第一次发送时的结果, Seq为0表示消息还未写入redis, Sent为false表示首次发送还未投递到mq.
+type MsgDedupRecord struct {
+	ServerMsgID string
+	SendTime    int64
+	Seq         int64
+	Sent        bool
+}
+
+// msgDedupCache 按clientMsgID对消息去重, 记录保存在redis中, 多个msg rpc、msgtransfer实例共享.
+type msgDedupCache interface {
+	// ClaimClientMsgID window内首次出现时保存record并返回nil, 否则返回首次保存的记录
+	ClaimClientMsgID(ctx context.Context, conversationID string, clientMsgID string, record *MsgDedupRecord, window time.Duration) (*MsgDedupRecord, error)
+	// MarkClientMsgIDSent 消息投递到mq后标记记录, 之后的重发直接返回首次发送的结果
+	MarkClientMsgIDSent(ctx context.Context, conversationID string, clientMsgID string) error
+	// ReleaseClientMsgID 发送失败且消息未投递到mq时删除记录, 客户端可以使用相同的clientMsgID重试
+	ReleaseClientMsgID(ctx context.Context, conversationID string, clientMsgID string) error
+	// GetClientMsgSeqs 返回已经分配过seq的clientMsgID, k: clientMsgID, v: seq
+	GetClientMsgSeqs(ctx context.Context, conversationID string, clientMsgIDs []string) (map[string]int64, error)
+	// SetClientMsgSeqs seqs k: clientMsgID, v: seq, 已存在的记录不覆盖
+	SetClientMsgSeqs(ctx context.Context, conversationID string, seqs map[string]int64, window time.Duration) error
+}
+
+// 同一会话的去重记录使用相同的hash tag, 保证在集群中位于同一个slot.
+func (c *msgCache) getMsgDedupKey(conversationID, clientMsgID string) string {
+	return msgDedup + "{" + conversationID + "}:" + clientMsgID
+}
+
+func (c *msgCache) getMsgDedupSeqKey(conversationID, clientMsgID string) string {
+	return msgDedupSeq + "{" + conversationID + "}:" + clientMsgID
+}
+
+func (c *msgCache) ClaimClientMsgID(ctx context.Context, conversationID string, clientMsgID string, record *MsgDedupRecord, window time.Duration) (*MsgDedupRecord, error) {
+	keys := []string{c.getMsgDedupKey(conversationID, clientMsgID), c.getMsgDedupSeqKey(conversationID, clientMsgID)}
+	res, err := claimClientMsgIDScript.Run(ctx, c.rdb, keys, record.ServerMsgID, record.SendTime, window.Milliseconds()).Slice()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	existing := &MsgDedupRecord{}
+	for i, v := range res {
+		s, _ := v.(string)
+		switch i {
+		case 0:
+			existing.ServerMsgID = s
+		case 1:
+			existing.SendTime, _ = strconv.ParseInt(s, 10, 64)
+		case 2:
+			existing.Seq, _ = strconv.ParseInt(s, 10, 64)
+		case 3:
+			// 旧版本的记录没有sent字段, 视为已投递
+			existing.Sent = s != "0"
+		}
+	}
+	return existing, nil
+}
+
+func (c *msgCache) ReleaseClientMsgID(ctx context.Context, conversationID string, clientMsgID string) error {
+	err := releaseClientMsgIDScript.Run(ctx, c.rdb, []string{c.getMsgDedupKey(conversationID, clientMsgID)}).Err()
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) MarkClientMsgIDSent(ctx context.Context, conversationID string, clientMsgID string) error {
+	err := markClientMsgIDSentScript.Run(ctx, c.rdb, []string{c.getMsgDedupKey(conversationID, clientMsgID)}).Err()
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) GetClientMsgSeqs(ctx context.Context, conversationID string, clientMsgIDs []string) (map[string]int64, error) {
+	if len(clientMsgIDs) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(clientMsgIDs))
+	for _, clientMsgID := range clientMsgIDs {
+		keys = append(keys, c.getMsgDedupSeqKey(conversationID, clientMsgID))
+	}
+	values, err := c.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	seqs := make(map[string]int64)
+	for i, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if seq, err := strconv.ParseInt(s, 10, 64); err == nil {
+			seqs[clientMsgIDs[i]] = seq
+		}
+	}
+	return seqs, nil
+}
+
+func (c *msgCache) SetClientMsgSeqs(ctx context.Context, conversationID string, seqs map[string]int64, window time.Duration) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for clientMsgID, seq := range seqs {
+		pipe.SetNX(ctx, c.getMsgDedupSeqKey(conversationID, clientMsgID), seq, window)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
@@ -0,0 +1,129 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
+
+const (
+	onlineRoute      = "ONLINE_ROUTE:"
+	gatewayHeartbeat = "GATEWAY_HEARTBEAT:"
+)
+
+// onlineRouteCache 记录用户连接所在的网关实例, 推送时只调用持有目标用户的网关.
+// 每个用户一个hash, field为网关地址, value为该网关上的平台列表.
+type onlineRouteCache interface {
+	// routes k: userID, v: platformIDs, 平台列表为空表示用户已不在该网关
+	SetUsersRoute(ctx context.Context, gatewayAddr string, routes map[string][]int, expire time.Duration) error
+	// k: userID, v: gatewayAddr -> platformIDs
+	GetUsersRoutes(ctx context.Context, userIDs []string) (map[string]map[string][]int, error)
+	SetGatewayHeartbeat(ctx context.Context, gatewayAddr string, expire time.Duration) error
+	GetAliveGateways(ctx context.Context, gatewayAddrs []string) ([]string, error)
+}
+
+func (c *msgCache) getOnlineRouteKey(userID string) string {
+	return onlineRoute + userID
+}
+
+func (c *msgCache) getGatewayHeartbeatKey(gatewayAddr string) string {
+	return gatewayHeartbeat + gatewayAddr
+}
+
+func (c *msgCache) SetUsersRoute(ctx context.Context, gatewayAddr string, routes map[string][]int, expire time.Duration) error {
+	if len(routes) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for userID, platformIDs := range routes {
+		key := c.getOnlineRouteKey(userID)
+		if len(platformIDs) == 0 {
+			pipe.HDel(ctx, key, gatewayAddr)
+			continue
+		}
+		ids := make([]string, 0, len(platformIDs))
+		for _, platformID := range platformIDs {
+			ids = append(ids, strconv.Itoa(platformID))
+		}
+		pipe.HSet(ctx, key, gatewayAddr, strings.Join(ids, ","))
+		pipe.Expire(ctx, key, expire)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) GetUsersRoutes(ctx context.Context, userIDs []string) (map[string]map[string][]int, error) {
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(userIDs))
+	for _, userID := range userIDs {
+		cmds = append(cmds, pipe.HGetAll(ctx, c.getOnlineRouteKey(userID)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	routes := make(map[string]map[string][]int)
+	for i, cmd := range cmds {
+		for gatewayAddr, value := range cmd.Val() {
+			var platformIDs []int
+			for _, s := range strings.Split(value, ",") {
+				platformID, err := strconv.Atoi(s)
+				if err != nil {
+					continue
+				}
+				platformIDs = append(platformIDs, platformID)
+			}
+			if len(platformIDs) == 0 {
+				continue
+			}
+			if routes[userIDs[i]] == nil {
+				routes[userIDs[i]] = make(map[string][]int)
+			}
+			routes[userIDs[i]][gatewayAddr] = platformIDs
+		}
+	}
+	return routes, nil
+}
+
+func (c *msgCache) SetGatewayHeartbeat(ctx context.Context, gatewayAddr string, expire time.Duration) error {
+	return errs.Wrap(c.rdb.Set(ctx, c.getGatewayHeartbeatKey(gatewayAddr), time.Now().UnixMilli(), expire).Err())
+}
+
+func (c *msgCache) GetAliveGateways(ctx context.Context, gatewayAddrs []string) ([]string, error) {
+	if len(gatewayAddrs) == 0 {
+		return nil, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, 0, len(gatewayAddrs))
+	for _, gatewayAddr := range gatewayAddrs {
+		cmds = append(cmds, pipe.Get(ctx, c.getGatewayHeartbeatKey(gatewayAddr)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	var alive []string
+	for i, cmd := range cmds {
+		if cmd.Err() == nil {
+			alive = append(alive, gatewayAddrs[i])
+		}
+	}
+	return alive, nil
+}
@@ -0,0 +1,98 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+const (
+	userPresence        = "USER_PRESENCE:"
+	userPresenceChannel = "USER_PRESENCE_CHANNEL"
+
+	presenceCustomStateField = "customState"
+	presenceStatusTextField  = "statusText"
+)
+
+// presenceCache 保存用户自定义的在线状态, 并通过redis频道在网关之间广播用户状态变化.
+type presenceCache interface {
+	SetUserCustomPresence(ctx context.Context, userID string, customState int32, statusText string) error
+	// k: userID, 只填充customState和statusText
+	GetUsersCustomPresence(ctx context.Context, userIDs []string) (map[string]*sdkws.UserPresence, error)
+	PublishUserPresence(ctx context.Context, presence *sdkws.UserPresence) error
+	// ctx结束后取消订阅并关闭channel
+	SubscribeUserPresence(ctx context.Context) <-chan *redis.Message
+}
+
+func (c *msgCache) getUserPresenceKey(userID string) string {
+	return userPresence + userID
+}
+
+func (c *msgCache) SetUserCustomPresence(ctx context.Context, userID string, customState int32, statusText string) error {
+	key := c.getUserPresenceKey(userID)
+	if customState == 0 && statusText == "" {
+		return errs.Wrap(c.rdb.Del(ctx, key).Err())
+	}
+	return errs.Wrap(c.rdb.HSet(ctx, key, presenceCustomStateField, customState, presenceStatusTextField, statusText).Err())
+}
+
+func (c *msgCache) GetUsersCustomPresence(ctx context.Context, userIDs []string) (map[string]*sdkws.UserPresence, error) {
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(userIDs))
+	for _, userID := range userIDs {
+		cmds = append(cmds, pipe.HGetAll(ctx, c.getUserPresenceKey(userID)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	presences := make(map[string]*sdkws.UserPresence)
+	for i, cmd := range cmds {
+		m := cmd.Val()
+		if len(m) == 0 {
+			continue
+		}
+		customState, _ := strconv.Atoi(m[presenceCustomStateField])
+		presences[userIDs[i]] = &sdkws.UserPresence{
+			UserID:      userIDs[i],
+			CustomState: int32(customState),
+			StatusText:  m[presenceStatusTextField],
+		}
+	}
+	return presences, nil
+}
+
+func (c *msgCache) PublishUserPresence(ctx context.Context, presence *sdkws.UserPresence) error {
+	data, err := proto.Marshal(presence)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(c.rdb.Publish(ctx, userPresenceChannel, data).Err())
+}
+
+func (c *msgCache) SubscribeUserPresence(ctx context.Context) <-chan *redis.Message {
+	pubSub := c.rdb.Subscribe(ctx, userPresenceChannel)
+	go func() {
+		<-ctx.Done()
+		_ = pubSub.Close()
+	}()
+	return pubSub.Channel()
+}
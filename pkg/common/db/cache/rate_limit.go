@@ -0,0 +1,151 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
+
+const (
+	rateLimit          = "RATE_LIMIT:"
+	rateLimitViolation = "RATE_LIMIT_VIOLATION:"
+	rateLimitBan       = "RATE_LIMIT_BAN:"
+)
+
+// takeTokensScript 从KEYS中的每个令牌桶各取一个令牌, 所有桶都有令牌时才扣减.
+// ARGV[1]为当前毫秒时间, 之后每个桶依次为rate(每秒生成的令牌数)和burst(桶容量).
+var takeTokensScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local tokens = {}
+for i = 1, #KEYS do
+	local rate = tonumber(ARGV[i * 2])
+	local burst = tonumber(ARGV[i * 2 + 1])
+	local v = redis.call('HMGET', KEYS[i], 'tokens', 'ts')
+	local t = tonumber(v[1])
+	local ts = tonumber(v[2])
+	if t == nil or ts == nil then
+		t = burst
+		ts = now
+	end
+	t = math.min(burst, t + math.max(0, now - ts) * rate / 1000)
+	if t < 1 then
+		return 0
+	end
+	tokens[i] = t
+end
+for i = 1, #KEYS do
+	local rate = tonumber(ARGV[i * 2])
+	local burst = tonumber(ARGV[i * 2 + 1])
+	redis.call('HSET', KEYS[i], 'tokens', tokens[i] - 1, 'ts', now)
+	redis.call('PEXPIRE', KEYS[i], math.ceil(burst * 1000 / rate) + 1000)
+end
+return 1
+`)
+
+// TokenBucket Rate为每秒生成的令牌数, Burst为桶容量, Rate不大于0表示不限制.
+type TokenBucket struct {
+	Rate  int
+	Burst int
+}
+
+// rateLimitCache 用户的令牌桶, 超限次数和禁止连接的用户保存在redis中, 多个网关实例共享.
+type rateLimitCache interface {
+	// TakeUserRateLimitToken 同时从用户的总令牌桶和用户在该请求类型上的令牌桶取令牌
+	TakeUserRateLimitToken(ctx context.Context, userID string, reqIdentifier int32, user TokenBucket, req TokenBucket) (bool, error)
+	// IncrRateLimitViolation 返回用户在window内被限流的次数
+	IncrRateLimitViolation(ctx context.Context, userID string, window time.Duration) (int64, error)
+	SetRateLimitBan(ctx context.Context, userID string, expire time.Duration) error
+	IsRateLimitBanned(ctx context.Context, userID string) (bool, error)
+}
+
+// 同一用户的令牌桶使用相同的hash tag, 保证在集群中位于同一个slot.
+func (c *msgCache) getUserRateLimitKey(userID string) string {
+	return rateLimit + "{" + userID + "}:user"
+}
+
+func (c *msgCache) getUserReqRateLimitKey(userID string, reqIdentifier int32) string {
+	return rateLimit + "{" + userID + "}:req:" + strconv.Itoa(int(reqIdentifier))
+}
+
+func (c *msgCache) getRateLimitViolationKey(userID string) string {
+	return rateLimitViolation + userID
+}
+
+func (c *msgCache) getRateLimitBanKey(userID string) string {
+	return rateLimitBan + userID
+}
+
+func (c *msgCache) TakeUserRateLimitToken(ctx context.Context, userID string, reqIdentifier int32, user TokenBucket, req TokenBucket) (bool, error) {
+	var (
+		keys []string
+		args = []any{time.Now().UnixMilli()}
+	)
+	for i, bucket := range []TokenBucket{user, req} {
+		if bucket.Rate <= 0 {
+			continue
+		}
+		burst := bucket.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		if i == 0 {
+			keys = append(keys, c.getUserRateLimitKey(userID))
+		} else {
+			keys = append(keys, c.getUserReqRateLimitKey(userID, reqIdentifier))
+		}
+		args = append(args, bucket.Rate, burst)
+	}
+	if len(keys) == 0 {
+		return true, nil
+	}
+	ok, err := takeTokensScript.Run(ctx, c.rdb, keys, args...).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return ok == 1, nil
+}
+
+func (c *msgCache) IncrRateLimitViolation(ctx context.Context, userID string, window time.Duration) (int64, error) {
+	key := c.getRateLimitViolationKey(userID)
+	n, err := c.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	// 从第一次被限流开始计算窗口
+	if n == 1 {
+		if err := c.rdb.Expire(ctx, key, window).Err(); err != nil {
+			return 0, errs.Wrap(err)
+		}
+	}
+	return n, nil
+}
+
+func (c *msgCache) SetRateLimitBan(ctx context.Context, userID string, expire time.Duration) error {
+	return errs.Wrap(c.rdb.Set(ctx, c.getRateLimitBanKey(userID), time.Now().UnixMilli(), expire).Err())
+}
+
+func (c *msgCache) IsRateLimitBanned(ctx context.Context, userID string) (bool, error) {
+	n, err := c.rdb.Exists(ctx, c.getRateLimitBanKey(userID)).Result()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n > 0, nil
+}
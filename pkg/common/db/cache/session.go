@@ -0,0 +1,92 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
+
+const (
+	resumeSession = "RESUME_SESSION:"
+
+	resumeSessionUserIDField     = "userID"
+	resumeSessionPlatformIDField = "platformID"
+	resumeSessionSeqFieldPrefix  = "seq:"
+)
+
+// resumeSessionCache 保存断开的长连接已发送给客户端的seq, 客户端在有效期内重连时据此补发变化的会话.
+type resumeSessionCache interface {
+	// seqs k: conversationID, v: 已发送给客户端的最大seq
+	SetResumeSession(ctx context.Context, token string, userID string, platformID int, seqs map[string]int64, expire time.Duration) error
+	// TakeResumeSession 取出并删除会话, 会话不存在时返回redis.Nil
+	TakeResumeSession(ctx context.Context, token string) (userID string, platformID int, seqs map[string]int64, err error)
+}
+
+func (c *msgCache) getResumeSessionKey(token string) string {
+	return resumeSession + token
+}
+
+func (c *msgCache) SetResumeSession(ctx context.Context, token string, userID string, platformID int, seqs map[string]int64, expire time.Duration) error {
+	key := c.getResumeSessionKey(token)
+	values := make(map[string]any, len(seqs)+2)
+	values[resumeSessionUserIDField] = userID
+	values[resumeSessionPlatformIDField] = platformID
+	for conversationID, seq := range seqs {
+		values[resumeSessionSeqFieldPrefix+conversationID] = seq
+	}
+	pipe := c.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, values)
+	pipe.Expire(ctx, key, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) TakeResumeSession(ctx context.Context, token string) (userID string, platformID int, seqs map[string]int64, err error) {
+	key := c.getResumeSessionKey(token)
+	pipe := c.rdb.TxPipeline()
+	get := pipe.HGetAll(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", 0, nil, errs.Wrap(err)
+	}
+	m := get.Val()
+	if len(m) == 0 {
+		return "", 0, nil, errs.Wrap(redis.Nil)
+	}
+	seqs = make(map[string]int64, len(m))
+	for field, value := range m {
+		switch {
+		case field == resumeSessionUserIDField:
+			userID = value
+		case field == resumeSessionPlatformIDField:
+			platformID, _ = strconv.Atoi(value)
+		case strings.HasPrefix(field, resumeSessionSeqFieldPrefix):
+			seq, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			seqs[strings.TrimPrefix(field, resumeSessionSeqFieldPrefix)] = seq
+		}
+	}
+	return userID, platformID, seqs, nil
+}
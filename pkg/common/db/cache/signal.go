@@ -0,0 +1,284 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const (
+	signalTimeout = "SIGNAL_TIMEOUT"
+	signalBusy    = "SIGNAL_BUSY:"
+	signalLocker  = "SIGNAL_LOCK:"
+
+	signalInvitationField = "invitation"
+)
+
+// signalingCache 音视频通话的房间状态.
+// SIGNAL_CACHE:roomID 为hash, invitation字段存邀请信息, 其余字段为userID -> 参与者状态;
+// SIGNAL_LIST_CACHE:userID 为用户待应答的房间, score为超时时间;
+// SIGNAL_TIMEOUT 为所有待超时的房间, score为超时时间.
+type signalingCache interface {
+	// CreateSignalRoom states k: userID, v: state, 房间已存在时不修改并返回false
+	CreateSignalRoom(ctx context.Context, roomID string, invitation []byte, states map[string]int32, expire time.Duration) (bool, error)
+	GetSignalRoom(ctx context.Context, roomID string) (invitation []byte, states map[string]int32, err error)
+	SetSignalRoomStates(ctx context.Context, roomID string, states map[string]int32) error
+	DelSignalRoom(ctx context.Context, roomID string) error
+	AddSignalInvitations(ctx context.Context, roomID string, userIDs []string, deadline int64) error
+	DelSignalInvitations(ctx context.Context, roomID string, userIDs []string) error
+	GetSignalInvitationRoomIDs(ctx context.Context, userID string, now int64) ([]string, error)
+	GetTimeoutSignalRoomIDs(ctx context.Context, now int64, count int64) ([]string, error)
+	// TakeTimeoutSignalRoom 多个实例同时扫描时只有一个能取到, 取到后超时时间顺延到lease,
+	// 处理成功后调用DelTimeoutSignalRoom删除, 处理失败或实例崩溃时lease到期后会被重新取到
+	TakeTimeoutSignalRoom(ctx context.Context, roomID string, now int64, lease int64) (bool, error)
+	DelTimeoutSignalRoom(ctx context.Context, roomID string) error
+	SetSignalBusyUsers(ctx context.Context, roomID string, userIDs []string, expire time.Duration) error
+	// k: userID, v: roomID
+	GetSignalBusyUsers(ctx context.Context, userIDs []string) (map[string]string, error)
+	DelSignalBusyUsers(ctx context.Context, roomID string, userIDs []string) error
+	// LockSignalRoom 返回的token用于解锁, 防止锁过期后删除了别人的锁
+	LockSignalRoom(ctx context.Context, roomID string) (string, error)
+	UnLockSignalRoom(ctx context.Context, roomID string, token string) error
+}
+
+func (c *msgCache) getSignalRoomKey(roomID string) string {
+	return signalCache + roomID
+}
+
+func (c *msgCache) getSignalListKey(userID string) string {
+	return signalListCache + userID
+}
+
+func (c *msgCache) getSignalBusyKey(userID string) string {
+	return signalBusy + userID
+}
+
+// createSignalRoomScript KEYS[1] 房间key, ARGV[1]过期毫秒数, 之后依次为hash的field和value.
+var createSignalRoomScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV, 2))
+redis.call('PEXPIRE', KEYS[1], ARGV[1])
+return 1
+`)
+
+func (c *msgCache) CreateSignalRoom(ctx context.Context, roomID string, invitation []byte, states map[string]int32, expire time.Duration) (bool, error) {
+	args := []any{expire.Milliseconds(), signalInvitationField, invitation}
+	for userID, state := range states {
+		args = append(args, userID, state)
+	}
+	n, err := createSignalRoomScript.Run(ctx, c.rdb, []string{c.getSignalRoomKey(roomID)}, args...).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n == 1, nil
+}
+
+func (c *msgCache) GetSignalRoom(ctx context.Context, roomID string) (invitation []byte, states map[string]int32, err error) {
+	values, err := c.rdb.HGetAll(ctx, c.getSignalRoomKey(roomID)).Result()
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	if _, ok := values[signalInvitationField]; !ok {
+		return nil, nil, errs.Wrap(redis.Nil)
+	}
+	states = make(map[string]int32, len(values)-1)
+	for field, value := range values {
+		if field == signalInvitationField {
+			invitation = []byte(value)
+			continue
+		}
+		state, err := strconv.Atoi(value)
+		if err != nil {
+			continue
+		}
+		states[field] = int32(state)
+	}
+	return invitation, states, nil
+}
+
+func (c *msgCache) SetSignalRoomStates(ctx context.Context, roomID string, states map[string]int32) error {
+	if len(states) == 0 {
+		return nil
+	}
+	values := make([]any, 0, len(states)*2)
+	for userID, state := range states {
+		values = append(values, userID, state)
+	}
+	return errs.Wrap(c.rdb.HSet(ctx, c.getSignalRoomKey(roomID), values...).Err())
+}
+
+func (c *msgCache) DelSignalRoom(ctx context.Context, roomID string) error {
+	pipe := c.rdb.Pipeline()
+	pipe.Del(ctx, c.getSignalRoomKey(roomID))
+	pipe.ZRem(ctx, signalTimeout, roomID)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) AddSignalInvitations(ctx context.Context, roomID string, userIDs []string, deadline int64) error {
+	member := redis.Z{Score: float64(deadline), Member: roomID}
+	expire := time.Until(time.UnixMilli(deadline))
+	pipe := c.rdb.Pipeline()
+	for _, userID := range userIDs {
+		key := c.getSignalListKey(userID)
+		pipe.ZAdd(ctx, key, member)
+		// 过期的邀请在查询时过滤, 这里只保证key最终会被删除
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(utils.GetCurrentTimestampByMill(), 10))
+		pipe.Expire(ctx, key, expire)
+	}
+	pipe.ZAdd(ctx, signalTimeout, member)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) DelSignalInvitations(ctx context.Context, roomID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for _, userID := range userIDs {
+		pipe.ZRem(ctx, c.getSignalListKey(userID), roomID)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) GetSignalInvitationRoomIDs(ctx context.Context, userID string, now int64) ([]string, error) {
+	roomIDs, err := c.rdb.ZRangeByScore(ctx, c.getSignalListKey(userID), &redis.ZRangeBy{
+		Min: strconv.FormatInt(now, 10),
+		Max: "+inf",
+	}).Result()
+	return roomIDs, errs.Wrap(err)
+}
+
+func (c *msgCache) GetTimeoutSignalRoomIDs(ctx context.Context, now int64, count int64) ([]string, error) {
+	roomIDs, err := c.rdb.ZRangeByScore(ctx, signalTimeout, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now, 10),
+		Count: count,
+	}).Result()
+	return roomIDs, errs.Wrap(err)
+}
+
+// takeTimeoutSignalRoomScript KEYS[1] SIGNAL_TIMEOUT, ARGV[1] roomID, ARGV[2]当前时间, ARGV[3]顺延后的超时时间.
+var takeTimeoutSignalRoomScript = redis.NewScript(`
+local score = redis.call('ZSCORE', KEYS[1], ARGV[1])
+if not score or tonumber(score) > tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[3], ARGV[1])
+return 1
+`)
+
+func (c *msgCache) TakeTimeoutSignalRoom(ctx context.Context, roomID string, now int64, lease int64) (bool, error) {
+	n, err := takeTimeoutSignalRoomScript.Run(ctx, c.rdb, []string{signalTimeout}, roomID, now, lease).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n > 0, nil
+}
+
+func (c *msgCache) DelTimeoutSignalRoom(ctx context.Context, roomID string) error {
+	return errs.Wrap(c.rdb.ZRem(ctx, signalTimeout, roomID).Err())
+}
+
+func (c *msgCache) SetSignalBusyUsers(ctx context.Context, roomID string, userIDs []string, expire time.Duration) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for _, userID := range userIDs {
+		pipe.Set(ctx, c.getSignalBusyKey(userID), roomID, expire)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) GetSignalBusyUsers(ctx context.Context, userIDs []string) (map[string]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, 0, len(userIDs))
+	for _, userID := range userIDs {
+		cmds = append(cmds, pipe.Get(ctx, c.getSignalBusyKey(userID)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	busy := make(map[string]string)
+	for i, cmd := range cmds {
+		if roomID, err := cmd.Result(); err == nil {
+			busy[userIDs[i]] = roomID
+		}
+	}
+	return busy, nil
+}
+
+func (c *msgCache) DelSignalBusyUsers(ctx context.Context, roomID string, userIDs []string) error {
+	busy, err := c.GetSignalBusyUsers(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	var keys []string
+	for userID, busyRoomID := range busy {
+		// 用户可能已经在别的房间里
+		if busyRoomID == roomID {
+			keys = append(keys, c.getSignalBusyKey(userID))
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for _, key := range keys {
+		pipe.Del(ctx, key)
+	}
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+// unlockSignalRoomScript 只删除自己持有的锁.
+var unlockSignalRoomScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+func (c *msgCache) LockSignalRoom(ctx context.Context, roomID string) (string, error) {
+	token := utils.OperationIDGenerator()
+	ok, err := c.rdb.SetNX(ctx, signalLocker+roomID, token, time.Second*10).Result()
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	if !ok {
+		return "", errs.ErrInternalServer.Wrap("signal room is locked")
+	}
+	return token, nil
+}
+
+func (c *msgCache) UnLockSignalRoom(ctx context.Context, roomID string, token string) error {
+	return errs.Wrap(unlockSignalRoomScript.Run(ctx, c.rdb, []string{signalLocker + roomID}, token).Err())
+}
@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+)
+
+type EphemeralDatabase interface {
+	// IncrSendCount 返回用户当前这一秒内已发送的临时事件数量(包括本次)
+	IncrSendCount(ctx context.Context, userID string) (int64, error)
+}
+
+type ephemeralDatabase struct {
+	cache cache.MsgModel
+}
+
+func NewEphemeralDatabase(cache cache.MsgModel) EphemeralDatabase {
+	return &ephemeralDatabase{cache: cache}
+}
+
+func (e *ephemeralDatabase) IncrSendCount(ctx context.Context, userID string) (int64, error) {
+	return e.cache.IncrEphemeralSendCount(ctx, userID)
+}
@@ -22,12 +22,15 @@ import (
 	"github.com/redis/go-redis/v9"
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/convert"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/engine"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/search"
 	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/unrelation"
-	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/kafka"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mq"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/prome"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
 
@@ -43,18 +46,47 @@ const (
 	updateKeyRevoke
 )
 
+// 全文检索每批校验的命中数, 以及一次搜索最多校验的命中数
+const (
+	searchHitsBatch   = 1000
+	searchMaxScanHits = searchHitsBatch * 10
+)
+
+// 重发时等待首次发送投递到mq的最长时间和检查间隔
+const (
+	clientMsgIDSendingWait     = time.Second * 5
+	clientMsgIDSendingInterval = time.Millisecond * 100
+)
+
+// 回填和修改reaction缓存共用的锁, 修改时取锁的重试次数和间隔
+const (
+	reactionCacheLock         = "REACTION_CACHE"
+	reactionCacheLockRetry    = 3
+	reactionCacheLockInterval = time.Millisecond * 100
+)
+
 type CommonMsgDatabase interface {
 	// 批量插入消息
 	BatchInsertChat2DB(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, currentMaxSeq int64) error
 	// 撤回消息
 	RevokeMsg(ctx context.Context, conversationID string, seq int64, revoke *unRelationTb.RevokeModel) error
+	// 编辑消息, edit保存编辑前的内容
+	EditMsg(ctx context.Context, conversationID string, seq int64, content string, edit *unRelationTb.EditModel) error
+	// 消息回应扩展, 返回值以seq为key
+	GetMsgsReactionExtensions(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (map[int64]map[string]*sdkws.KeyValue, error)
+	SetMsgReactionExtension(ctx context.Context, conversationID string, msg *sdkws.MsgData, userID string, kv *sdkws.KeyValue) error
+	DeleteMsgReactionExtension(ctx context.Context, conversationID string, msg *sdkws.MsgData, typeKey string) error
 	// mark as read
 	MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, seqs []int64) error
 	// 刪除redis中消息缓存
 	DeleteMessagesFromCache(ctx context.Context, conversationID string, seqs []int64) error
 	DelUserDeleteMsgsList(ctx context.Context, conversationID string, seqs []int64)
-	// incrSeq然后批量插入缓存
-	BatchInsertChat2Cache(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (seq int64, isNewConversation bool, err error)
+	// incrSeq然后批量插入缓存, 跳过去重窗口内已经插入过的clientMsgID, inserted为实际插入的消息
+	BatchInsertChat2Cache(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (seq int64, isNewConversation bool, inserted []*sdkws.MsgData, err error)
+	// ClaimClientMsgID 去重窗口内首次发送时返回nil, 重发时等待首次发送投递到mq后返回其结果
+	ClaimClientMsgID(ctx context.Context, msg *sdkws.MsgData) (*cache.MsgDedupRecord, error)
+	// ReleaseClientMsgID 发送失败且消息未投递到mq时释放clientMsgID, 允许客户端重试
+	ReleaseClientMsgID(ctx context.Context, msg *sdkws.MsgData) error
 
 	//  通过seqList获取mongo中写扩散消息
 	GetMsgBySeqsRange(ctx context.Context, userID string, conversationID string, begin, end, num, userMaxSeq int64) (minSeq int64, maxSeq int64, seqMsg []*sdkws.MsgData, err error)
@@ -62,6 +94,8 @@ type CommonMsgDatabase interface {
 	GetMsgBySeqs(ctx context.Context, userID string, conversationID string, seqs []int64) (minSeq int64, maxSeq int64, seqMsg []*sdkws.MsgData, err error)
 	// 删除会话消息重置最小seq， remainTime为消息保留的时间单位秒,超时消息删除， 传0删除所有消息(此方法不删除redis cache)
 	DeleteConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64) error
+	// 按保留策略删除消息: remainTime小于0表示不限制保留时间, maxMsgNum为0表示不限制条数, dryRun只统计不删除
+	DeleteConversationMsgsByRetention(ctx context.Context, conversationID string, remainTime int64, maxMsgNum int64, dryRun bool) (delMsgNum int64, minSeq int64, err error)
 	// 用户标记删除过期消息返回标记删除的seq列表
 	UserMsgsDestruct(ctx context.Context, userID string, conversationID string, destructTime int64, lastMsgDestructTime time.Time) (seqs []int64, err error)
 
@@ -86,19 +120,39 @@ type CommonMsgDatabase interface {
 	SetHasReadSeq(ctx context.Context, userID string, conversationID string, hasReadSeq int64) error
 	GetHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	GetHasReadSeq(ctx context.Context, userID string, conversationID string) (int64, error)
+	// 群成员在会话中的已读seq, 未读过的成员不在返回值中
+	GetConversationUsersHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error)
+	// 话题的已读seq为话题内序号, 与会话seq无关
+	SetThreadHasReadSeq(ctx context.Context, userID string, conversationID string, rootSeq int64, hasReadSeq int64) error
+	GetThreadHasReadSeqs(ctx context.Context, userID string, conversationID string, rootSeqs []int64) (map[int64]int64, error)
 	UserSetHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]int64) error
 
 	GetMongoMaxAndMinSeq(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo int64, err error)
+	// 将最后一条消息早于before的已写满文档归档到对象存储, 返回归档的文档数
+	ArchiveMsgDocs(ctx context.Context, before time.Time, limit int) (int, error)
 	GetConversationMinMaxSeqInMongoAndCache(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo, minSeqCache, maxSeqCache int64, err error)
 	SetSendMsgStatus(ctx context.Context, id string, status int32) error
 	GetSendMsgStatus(ctx context.Context, id string) (int32, error)
+	// 投递状态: 接收(msg rpc) -> 落库(msgtransfer) -> 在线/离线推送(push) -> 客户端确认
+	InitMsgDeliveryStatus(ctx context.Context, msg *sdkws.MsgData) error
+	// GetMsgsDeliveryTargets 只返回跟踪了投递状态的消息, k: serverMsgID
+	GetMsgsDeliveryTargets(ctx context.Context, serverMsgIDs []string) (map[string]*cache.MsgDeliveryTarget, error)
+	SetMsgsPersisted(ctx context.Context, msgs []*sdkws.MsgData) error
+	AckMsgsDelivered(ctx context.Context, userID string, serverMsgIDs []string) error
+	// states k: userID(空字符串为消息级别的状态), v: state -> time
+	GetMsgDeliveryStatus(ctx context.Context, serverMsgID string) (sendID string, states map[string]map[int32]int64, err error)
 	SearchMessage(ctx context.Context, req *pbMsg.SearchMessageReq) (total int32, msgData []*sdkws.MsgData, err error)
+	GetThreads(ctx context.Context, conversationID string, rootSeqs []int64) ([]*unRelationTb.ThreadModel, error)
+	// 返回话题内序号[begin, end]对应的会话seq
+	GetThreadReplySeqs(ctx context.Context, conversationID string, rootSeq int64, begin, end int64) ([]int64, error)
 
 	// to mq
 	MsgToMQ(ctx context.Context, key string, msg2mq *sdkws.MsgData) error
 	MsgToModifyMQ(ctx context.Context, key, conversarionID string, msgs []*sdkws.MsgData) error
 	MsgToPushMQ(ctx context.Context, key, conversarionID string, msg2mq *sdkws.MsgData) (int32, int64, error)
 	MsgToMongoMQ(ctx context.Context, key, conversarionID string, msgs []*sdkws.MsgData, lastSeq int64) error
+	// 按deadLetter.Stage写入对应的死信topic
+	MsgToDeadLetterMQ(ctx context.Context, deadLetter *pbMsg.DeadLetterMsg) error
 
 	RangeUserSendCount(
 		ctx context.Context,
@@ -119,36 +173,82 @@ type CommonMsgDatabase interface {
 	) (msgCount int64, userCount int64, groups []*unRelationTb.GroupCount, dateCount map[string]int64, err error)
 }
 
-func NewCommonMsgDatabase(msgDocModel unRelationTb.MsgDocModelInterface, cacheModel cache.MsgModel) CommonMsgDatabase {
-	return &commonMsgDatabase{
-		msgDocDatabase:  msgDocModel,
-		cache:           cacheModel,
-		producer:        kafka.NewKafkaProducer(config.Config.Kafka.Addr, config.Config.Kafka.LatestMsgToRedis.Topic),
-		producerToMongo: kafka.NewKafkaProducer(config.Config.Kafka.Addr, config.Config.Kafka.MsgToMongo.Topic),
-		producerToPush:  kafka.NewKafkaProducer(config.Config.Kafka.Addr, config.Config.Kafka.MsgToPush.Topic),
+func NewCommonMsgDatabase(
+	msgDocModel unRelationTb.MsgDocModelInterface,
+	threadModel unRelationTb.ThreadModelInterface,
+	cacheModel cache.MsgModel,
+	searchIndex search.MessageSearchIndex,
+) (CommonMsgDatabase, error) {
+	db := &commonMsgDatabase{
+		msgDocDatabase: msgDocModel,
+		threadDatabase: threadModel,
+		searchIndex:    searchIndex,
+		cache:          cacheModel,
+	}
+	for _, p := range []struct {
+		producer *mq.Producer
+		topic    string
+	}{
+		{&db.producer, config.Config.Kafka.LatestMsgToRedis.Topic},
+		{&db.producerToMongo, config.Config.Kafka.MsgToMongo.Topic},
+		{&db.producerToPush, config.Config.Kafka.MsgToPush.Topic},
+		{&db.producerToRedisDeadLetter, config.Config.Kafka.MsgToRedisDeadLetter.Topic},
+		{&db.producerToMongoDeadLetter, config.Config.Kafka.MsgToMongoDeadLetter.Topic},
+		{&db.producerToSearchDeadLetter, config.Config.Kafka.MsgToSearchDeadLetter.Topic},
+	} {
+		producer, err := mq.NewProducer(p.topic)
+		if err != nil {
+			return nil, err
+		}
+		*p.producer = producer
+	}
+	if config.Config.MsgArchive.Enable {
+		o, err := engine.New()
+		if err != nil {
+			return nil, err
+		}
+		db.archive = newMsgArchive(o, msgDocModel, config.Config.MsgArchive.CacheSize)
 	}
+	return db, nil
 }
 
-func InitCommonMsgDatabase(rdb redis.UniversalClient, database *mongo.Database) CommonMsgDatabase {
+func InitCommonMsgDatabase(rdb redis.UniversalClient, database *mongo.Database) (CommonMsgDatabase, error) {
 	cacheModel := cache.NewMsgCacheModel(rdb)
 	msgDocModel := unrelation.NewMsgMongoDriver(database)
-	CommonMsgDatabase := NewCommonMsgDatabase(msgDocModel, cacheModel)
-	return CommonMsgDatabase
+	threadModel := unrelation.NewThreadMongoDriver(database)
+	return NewCommonMsgDatabase(msgDocModel, threadModel, cacheModel, nil)
 }
 
 type commonMsgDatabase struct {
 	msgDocDatabase   unRelationTb.MsgDocModelInterface
+	threadDatabase   unRelationTb.ThreadModelInterface
+	searchIndex      search.MessageSearchIndex
 	msg              unRelationTb.MsgDocModel
 	cache            cache.MsgModel
-	producer         *kafka.Producer
-	producerToMongo  *kafka.Producer
-	producerToModify *kafka.Producer
-	producerToPush   *kafka.Producer
+	producer         mq.Producer
+	producerToMongo  mq.Producer
+	producerToModify mq.Producer
+	producerToPush   mq.Producer
+
+	producerToRedisDeadLetter  mq.Producer
+	producerToMongoDeadLetter  mq.Producer
+	producerToSearchDeadLetter mq.Producer
+
+	// 未开启归档时为nil
+	archive *msgArchive
 }
 
 func (db *commonMsgDatabase) MsgToMQ(ctx context.Context, key string, msg2mq *sdkws.MsgData) error {
-	_, _, err := db.producer.SendMessage(ctx, key, msg2mq)
-	return err
+	if _, _, err := db.producer.SendMessage(ctx, key, msg2mq); err != nil {
+		return err
+	}
+	if db.msgDedupWindow() > 0 && msg2mq.ClientMsgID != "" {
+		// 标记失败时重发会等待到超时, 消息不会丢失
+		if err := db.cache.MarkClientMsgIDSent(ctx, utils.GetConversationIDByMsg(msg2mq), msg2mq.ClientMsgID); err != nil {
+			log.ZWarn(ctx, "MarkClientMsgIDSent failed", err, "clientMsgID", msg2mq.ClientMsgID)
+		}
+	}
+	return nil
 }
 
 func (db *commonMsgDatabase) MsgToModifyMQ(ctx context.Context, key, conversationID string, messages []*sdkws.MsgData) error {
@@ -168,6 +268,22 @@ func (db *commonMsgDatabase) MsgToPushMQ(ctx context.Context, key, conversationI
 	return partition, offset, nil
 }
 
+func (db *commonMsgDatabase) MsgToDeadLetterMQ(ctx context.Context, deadLetter *pbMsg.DeadLetterMsg) error {
+	var producer mq.Producer
+	switch deadLetter.Stage {
+	case constant.DeadLetterStageMsgToRedis:
+		producer = db.producerToRedisDeadLetter
+	case constant.DeadLetterStageMsgToMongo:
+		producer = db.producerToMongoDeadLetter
+	case constant.DeadLetterStageMsgToSearch:
+		producer = db.producerToSearchDeadLetter
+	default:
+		return errs.ErrArgs.Wrap("unknown dead letter stage " + deadLetter.Stage)
+	}
+	_, _, err := producer.SendMessage(ctx, deadLetter.Key, deadLetter)
+	return err
+}
+
 func (db *commonMsgDatabase) MsgToMongoMQ(ctx context.Context, key, conversationID string, messages []*sdkws.MsgData, lastSeq int64) error {
 	if len(messages) > 0 {
 		_, _, err := db.producerToMongo.SendMessage(ctx, key, &pbMsg.MsgDataToMongoByMQ{LastSeq: lastSeq, ConversationID: conversationID, MsgData: messages})
@@ -221,6 +337,7 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 		return res.MatchedCount > 0, nil
 	}
 	tryUpdate := true
+	dupIndex := -1 // 上一次插入时文档已存在的位置
 	for i := 0; i < len(fields); i++ {
 		seq := firstSeq + int64(i) // 当前seq
 		if tryUpdate {
@@ -262,9 +379,24 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 			if model.DelList == nil {
 				doc.Msg[i].DelList = []string{}
 			}
+			if model.EditHistory == nil {
+				doc.Msg[i].EditHistory = []*unRelationTb.EditModel{}
+			}
+			if model.Reactions == nil {
+				doc.Msg[i].Reactions = map[string]*unRelationTb.ReactionModel{}
+			}
 		}
 		if err := db.msgDocDatabase.Create(ctx, &doc); err != nil {
 			if mongo.IsDuplicateKeyError(err) {
+				// 修改未匹配且插入重复时文档只读(已归档), 不再重试
+				if dupIndex == i {
+					archive, aErr := db.msgDocDatabase.GetDocArchive(ctx, doc.DocID)
+					if aErr == nil && archive != nil {
+						return errs.ErrMsgArchived.Wrap("doc " + doc.DocID + " is archived")
+					}
+					return errs.Wrap(err)
+				}
+				dupIndex = i
 				i--              // 存在并发,重试当前数据
 				tryUpdate = true // 以修改模式
 				continue
@@ -318,15 +450,193 @@ func (db *commonMsgDatabase) BatchInsertChat2DB(ctx context.Context, conversatio
 			AtUserIDList:     msg.AtUserIDList,
 			AttachedInfo:     msg.AttachedInfo,
 			Ex:               msg.Ex,
+			ThreadRootSeq:    msg.ThreadRootSeq,
+		}
+	}
+	if err := db.BatchInsertBlock(ctx, conversationID, msgs, updateKeyMsg, msgList[0].Seq); err != nil {
+		return err
+	}
+	for _, msg := range msgList {
+		if msg == nil || msg.ThreadRootSeq <= 0 {
+			continue
+		}
+		if err := db.threadDatabase.AddReply(ctx, conversationID, msg.ThreadRootSeq, msg.Seq, msg.SendTime); err != nil {
+			return err
 		}
 	}
-	return db.BatchInsertBlock(ctx, conversationID, msgs, updateKeyMsg, msgList[0].Seq)
+	return nil
 }
 
 func (db *commonMsgDatabase) RevokeMsg(ctx context.Context, conversationID string, seq int64, revoke *unRelationTb.RevokeModel) error {
 	return db.BatchInsertBlock(ctx, conversationID, []any{revoke}, updateKeyRevoke, seq)
 }
 
+func (db *commonMsgDatabase) EditMsg(ctx context.Context, conversationID string, seq int64, content string, edit *unRelationTb.EditModel) error {
+	res, err := db.msgDocDatabase.EditMsgContent(ctx, db.msg.GetDocID(conversationID, seq), db.msg.GetMsgIndex(seq), content, edit)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errs.ErrRecordNotFound.Wrap("msg not persisted yet or edited concurrently")
+	}
+	return db.cache.DeleteMessages(ctx, conversationID, []int64{seq})
+}
+
+func (db *commonMsgDatabase) GetMsgsReactionExtensions(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (map[int64]map[string]*sdkws.KeyValue, error) {
+	reactions := make(map[int64]map[string]*sdkws.KeyValue, len(msgs))
+	missMsgs := make(map[int64]*sdkws.MsgData)
+	for _, msg := range msgs {
+		exist, err := db.cache.JudgeMessageReactionExist(ctx, msg.ClientMsgID, msg.SessionType)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			missMsgs[msg.Seq] = msg
+			continue
+		}
+		values, err := db.cache.GetOneMessageAllReactionList(ctx, msg.ClientMsgID, msg.SessionType)
+		if err != nil {
+			return nil, err
+		}
+		kvs := make(map[string]*sdkws.KeyValue, len(values))
+		for typeKey, value := range values {
+			var kv sdkws.KeyValue
+			if err := utils.JsonStringToStruct(value, &kv); err != nil {
+				return nil, err
+			}
+			kvs[typeKey] = &kv
+		}
+		reactions[msg.Seq] = kvs
+	}
+	if len(missMsgs) == 0 {
+		return reactions, nil
+	}
+	for docID, seqs := range db.msg.GetDocIDSeqsMap(conversationID, utils.Keys(missMsgs)) {
+		infos, err := db.msgDocDatabase.GetMsgBySeqIndexIn1Doc(ctx, "", docID, seqs)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if info == nil || info.Msg == nil {
+				continue
+			}
+			msg, ok := missMsgs[info.Msg.Seq]
+			if !ok {
+				continue
+			}
+			kvs := reactionKeyValues(info)
+			reactions[msg.Seq] = kvs
+			if len(kvs) == 0 {
+				continue
+			}
+			if err := db.fillReactionCache(ctx, docID, msg); err != nil {
+				log.ZWarn(ctx, "fillReactionCache failed", err, "conversationID", conversationID, "seq", msg.Seq)
+			}
+		}
+	}
+	return reactions, nil
+}
+
+func reactionKeyValues(info *unRelationTb.MsgInfoModel) map[string]*sdkws.KeyValue {
+	kvs := make(map[string]*sdkws.KeyValue, len(info.Reactions))
+	for typeKey, reaction := range info.Reactions {
+		kvs[typeKey] = &sdkws.KeyValue{TypeKey: typeKey, Value: reaction.Value, LatestUpdateTime: reaction.LatestUpdateTime}
+	}
+	return kvs
+}
+
+// lockReactionCache 回填和修改消息的reaction缓存前加锁, 回填时在锁内重新读取mongo, 不会覆盖并发修改写入的缓存.
+func (db *commonMsgDatabase) lockReactionCache(ctx context.Context, clientMsgID string, retry int) (err error) {
+	for i := 0; i < retry; i++ {
+		if err = db.cache.LockMessageTypeKey(ctx, clientMsgID, reactionCacheLock); err == nil {
+			return nil
+		}
+		time.Sleep(reactionCacheLockInterval)
+	}
+	return err
+}
+
+func (db *commonMsgDatabase) unlockReactionCache(ctx context.Context, clientMsgID string) {
+	if err := db.cache.UnLockMessageTypeKey(ctx, clientMsgID, reactionCacheLock); err != nil {
+		log.ZWarn(ctx, "unlock reaction cache failed", err, "clientMsgID", clientMsgID)
+	}
+}
+
+// fillReactionCache 缓存中没有消息的reaction时从mongo加载, 锁被占用时本次不回填.
+func (db *commonMsgDatabase) fillReactionCache(ctx context.Context, docID string, msg *sdkws.MsgData) error {
+	if err := db.lockReactionCache(ctx, msg.ClientMsgID, 1); err != nil {
+		return err
+	}
+	defer db.unlockReactionCache(ctx, msg.ClientMsgID)
+	exist, err := db.cache.JudgeMessageReactionExist(ctx, msg.ClientMsgID, msg.SessionType)
+	if err != nil || exist {
+		return err
+	}
+	infos, err := db.msgDocDatabase.GetMsgBySeqIndexIn1Doc(ctx, "", docID, []int64{msg.Seq})
+	if err != nil {
+		return err
+	}
+	if len(infos) == 0 || infos[0] == nil || infos[0].Msg == nil {
+		return nil
+	}
+	kvs := reactionKeyValues(infos[0])
+	if len(kvs) == 0 {
+		return nil
+	}
+	return db.setReactionsToCache(ctx, msg, kvs)
+}
+
+func (db *commonMsgDatabase) setReactionsToCache(ctx context.Context, msg *sdkws.MsgData, kvs map[string]*sdkws.KeyValue) error {
+	for typeKey, kv := range kvs {
+		if err := db.cache.SetMessageTypeKeyValue(ctx, msg.ClientMsgID, msg.SessionType, typeKey, utils.StructToJsonString(kv)); err != nil {
+			return err
+		}
+	}
+	_, err := db.cache.SetMessageReactionExpire(ctx, msg.ClientMsgID, msg.SessionType, time.Duration(config.Config.MsgCacheTimeout)*time.Second)
+	return err
+}
+
+func (db *commonMsgDatabase) SetMsgReactionExtension(ctx context.Context, conversationID string, msg *sdkws.MsgData, userID string, kv *sdkws.KeyValue) error {
+	reaction := &unRelationTb.ReactionModel{
+		TypeKey:          kv.TypeKey,
+		Value:            kv.Value,
+		UserID:           userID,
+		LatestUpdateTime: kv.LatestUpdateTime,
+	}
+	res, err := db.msgDocDatabase.UpdateMsg(ctx, db.msg.GetDocID(conversationID, msg.Seq), db.msg.GetMsgIndex(msg.Seq), "reactions."+kv.TypeKey, reaction)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errs.ErrRecordNotFound.Wrap("msg not persisted yet")
+	}
+	if err := db.lockReactionCache(ctx, msg.ClientMsgID, reactionCacheLockRetry); err != nil {
+		return err
+	}
+	defer db.unlockReactionCache(ctx, msg.ClientMsgID)
+	// 缓存不存在时不写入, 下次读取时从mongo整体加载
+	exist, err := db.cache.JudgeMessageReactionExist(ctx, msg.ClientMsgID, msg.SessionType)
+	if err != nil || !exist {
+		return err
+	}
+	return db.cache.SetMessageTypeKeyValue(ctx, msg.ClientMsgID, msg.SessionType, kv.TypeKey, utils.StructToJsonString(kv))
+}
+
+func (db *commonMsgDatabase) DeleteMsgReactionExtension(ctx context.Context, conversationID string, msg *sdkws.MsgData, typeKey string) error {
+	res, err := db.msgDocDatabase.UnsetMsg(ctx, db.msg.GetDocID(conversationID, msg.Seq), db.msg.GetMsgIndex(msg.Seq), "reactions."+typeKey)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errs.ErrRecordNotFound.Wrap("msg not persisted yet")
+	}
+	if err := db.lockReactionCache(ctx, msg.ClientMsgID, reactionCacheLockRetry); err != nil {
+		return err
+	}
+	defer db.unlockReactionCache(ctx, msg.ClientMsgID)
+	return db.cache.DeleteOneMessageKey(ctx, msg.ClientMsgID, msg.SessionType, typeKey)
+}
+
 func (db *commonMsgDatabase) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, totalSeqs []int64) error {
 	for docID, seqs := range db.msg.GetDocIDSeqsMap(conversationID, totalSeqs) {
 		var indexes []int64
@@ -350,23 +660,30 @@ func (db *commonMsgDatabase) DelUserDeleteMsgsList(ctx context.Context, conversa
 	db.cache.DelUserDeleteMsgsList(ctx, conversationID, seqs)
 }
 
-func (db *commonMsgDatabase) BatchInsertChat2Cache(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (seq int64, isNew bool, err error) {
+func (db *commonMsgDatabase) BatchInsertChat2Cache(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) (seq int64, isNew bool, inserted []*sdkws.MsgData, err error) {
 	currentMaxSeq, err := db.cache.GetMaxSeq(ctx, conversationID)
 	if err != nil && errs.Unwrap(err) != redis.Nil {
 		prome.Inc(prome.SeqGetFailedCounter)
-		return 0, false, err
+		return 0, false, nil, err
 	}
 	prome.Inc(prome.SeqGetSuccessCounter)
 	lenList := len(msgs)
 	if int64(lenList) > db.msg.GetSingleGocMsgNum() {
-		return 0, false, errors.New("too large")
+		return 0, false, nil, errors.New("too large")
 	}
 	if lenList < 1 {
-		return 0, false, errors.New("too short as 0")
+		return 0, false, nil, errors.New("too short as 0")
 	}
 	if errs.Unwrap(err) == redis.Nil {
 		isNew = true
 	}
+	msgs, err = db.skipDuplicateMsgs(ctx, conversationID, msgs)
+	if err != nil {
+		return 0, false, nil, err
+	}
+	if len(msgs) == 0 {
+		return currentMaxSeq, false, nil, nil
+	}
 	lastMaxSeq := currentMaxSeq
 	userSeqMap := make(map[string]int64)
 	for _, m := range msgs {
@@ -386,6 +703,7 @@ func (db *commonMsgDatabase) BatchInsertChat2Cache(ctx context.Context, conversa
 		prome.Inc(prome.SeqSetFailedCounter)
 	} else {
 		prome.Inc(prome.SeqSetSuccessCounter)
+		db.setClientMsgSeqs(ctx, conversationID, msgs)
 	}
 	err2 := db.cache.SetHasReadSeqs(ctx, conversationID, userSeqMap)
 	if err != nil {
@@ -394,7 +712,91 @@ func (db *commonMsgDatabase) BatchInsertChat2Cache(ctx context.Context, conversa
 	} else {
 		prome.Inc(prome.SeqSetSuccessCounter)
 	}
-	return lastMaxSeq, isNew, utils.Wrap(err, "")
+	return lastMaxSeq, isNew, msgs, utils.Wrap(err, "")
+}
+
+func (db *commonMsgDatabase) msgDedupWindow() time.Duration {
+	return time.Duration(config.Config.MsgDedupWindow) * time.Second
+}
+
+// skipDuplicateMsgs 跳过去重窗口内已经分配过seq的消息(mq重复投递、死信重放)和同一批次中重复的clientMsgID.
+func (db *commonMsgDatabase) skipDuplicateMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) ([]*sdkws.MsgData, error) {
+	if db.msgDedupWindow() <= 0 {
+		return msgs, nil
+	}
+	clientMsgIDs := make([]string, 0, len(msgs))
+	for _, m := range msgs {
+		if m.ClientMsgID != "" {
+			clientMsgIDs = append(clientMsgIDs, m.ClientMsgID)
+		}
+	}
+	seqs, err := db.cache.GetClientMsgSeqs(ctx, conversationID, clientMsgIDs)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{}, len(clientMsgIDs))
+	res := make([]*sdkws.MsgData, 0, len(msgs))
+	for _, m := range msgs {
+		if m.ClientMsgID != "" {
+			if seq, ok := seqs[m.ClientMsgID]; ok {
+				log.ZWarn(ctx, "skip duplicate msg", nil, "conversationID", conversationID, "clientMsgID", m.ClientMsgID, "seq", seq)
+				continue
+			}
+			if _, ok := seen[m.ClientMsgID]; ok {
+				log.ZWarn(ctx, "skip duplicate msg in batch", nil, "conversationID", conversationID, "clientMsgID", m.ClientMsgID)
+				continue
+			}
+			seen[m.ClientMsgID] = struct{}{}
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
+
+func (db *commonMsgDatabase) setClientMsgSeqs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) {
+	if db.msgDedupWindow() <= 0 {
+		return
+	}
+	seqs := make(map[string]int64, len(msgs))
+	for _, m := range msgs {
+		if m.ClientMsgID != "" {
+			seqs[m.ClientMsgID] = m.Seq
+		}
+	}
+	if err := db.cache.SetClientMsgSeqs(ctx, conversationID, seqs, db.msgDedupWindow()); err != nil {
+		log.ZWarn(ctx, "set client msg seqs failed", err, "conversationID", conversationID, "len", len(seqs))
+	}
+}
+
+func (db *commonMsgDatabase) ClaimClientMsgID(ctx context.Context, msg *sdkws.MsgData) (*cache.MsgDedupRecord, error) {
+	if db.msgDedupWindow() <= 0 || msg.ClientMsgID == "" {
+		return nil, nil
+	}
+	conversationID := utils.GetConversationIDByMsg(msg)
+	record := &cache.MsgDedupRecord{ServerMsgID: msg.ServerMsgID, SendTime: msg.SendTime}
+	deadline := time.Now().Add(clientMsgIDSendingWait)
+	for {
+		existing, err := db.cache.ClaimClientMsgID(ctx, conversationID, msg.ClientMsgID, record, db.msgDedupWindow())
+		if err != nil || existing == nil || existing.Sent {
+			return existing, err
+		}
+		// 首次发送还在进行中, 等待其投递到mq或失败释放后由本次发送
+		if time.Now().After(deadline) {
+			return nil, errs.ErrMsgSending.Wrap("clientMsgID " + msg.ClientMsgID)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errs.Wrap(ctx.Err())
+		case <-time.After(clientMsgIDSendingInterval):
+		}
+	}
+}
+
+func (db *commonMsgDatabase) ReleaseClientMsgID(ctx context.Context, msg *sdkws.MsgData) error {
+	if db.msgDedupWindow() <= 0 || msg.ClientMsgID == "" {
+		return nil
+	}
+	return db.cache.ReleaseClientMsgID(ctx, utils.GetConversationIDByMsg(msg), msg.ClientMsgID)
 }
 
 func (db *commonMsgDatabase) getMsgBySeqs(ctx context.Context, userID, conversationID string, seqs []int64) (totalMsgs []*sdkws.MsgData, err error) {
@@ -413,6 +815,14 @@ func (db *commonMsgDatabase) getMsgBySeqs(ctx context.Context, userID, conversat
 
 func (db *commonMsgDatabase) findMsgInfoBySeq(ctx context.Context, userID, docID string, seqs []int64) (totalMsgs []*unRelationTb.MsgInfoModel, err error) {
 	msgs, err := db.msgDocDatabase.GetMsgBySeqIndexIn1Doc(ctx, userID, docID, seqs)
+	if err == nil && db.archive != nil && len(msgs) < len(seqs) {
+		// 文档归档后mongo中不再有消息
+		var archive *unRelationTb.MsgDocArchiveModel
+		archive, err = db.msgDocDatabase.GetDocArchive(ctx, docID)
+		if err == nil && archive != nil {
+			msgs, err = db.archive.findMsgs(ctx, userID, archive, seqs)
+		}
+	}
 	for _, msg := range msgs {
 		if msg.IsRead {
 			msg.Msg.IsRead = true
@@ -614,16 +1024,41 @@ func (db *commonMsgDatabase) GetMsgBySeqs(ctx context.Context, userID string, co
 }
 
 func (db *commonMsgDatabase) DeleteConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64) error {
-	var delStruct delMsgRecursionStruct
+	return db.deleteConversationMsgsAndSetMinSeq(ctx, conversationID, remainTime, &delMsgRecursionStruct{})
+}
+
+func (db *commonMsgDatabase) DeleteConversationMsgsByRetention(ctx context.Context, conversationID string, remainTime int64, maxMsgNum int64, dryRun bool) (int64, int64, error) {
+	delStruct := delMsgRecursionStruct{dryRun: dryRun}
+	if maxMsgNum > 0 {
+		maxSeq, err := db.cache.GetMaxSeq(ctx, conversationID)
+		if err != nil && errs.Unwrap(err) != redis.Nil {
+			return 0, 0, err
+		}
+		delStruct.maxDelSeq = maxSeq - maxMsgNum
+	}
+	if remainTime < 0 && delStruct.maxDelSeq <= 0 {
+		return 0, 0, nil
+	}
+	if err := db.deleteConversationMsgsAndSetMinSeq(ctx, conversationID, remainTime, &delStruct); err != nil {
+		return 0, 0, err
+	}
+	return delStruct.delMsgNum, delStruct.setMinSeq, nil
+}
+
+func (db *commonMsgDatabase) deleteConversationMsgsAndSetMinSeq(ctx context.Context, conversationID string, remainTime int64, delStruct *delMsgRecursionStruct) error {
 	var skip int64
-	minSeq, err := db.deleteMsgRecursion(ctx, conversationID, skip, &delStruct, remainTime)
+	minSeq, err := db.deleteMsgRecursion(ctx, conversationID, skip, delStruct, remainTime)
 	if err != nil {
 		return err
 	}
-	log.ZInfo(ctx, "DeleteConversationMsgsAndSetMinSeq", "conversationID", conversationID, "minSeq", minSeq)
+	log.ZInfo(ctx, "DeleteConversationMsgsAndSetMinSeq", "conversationID", conversationID, "minSeq", minSeq, "dryRun", delStruct.dryRun)
 	if minSeq == 0 {
 		return nil
 	}
+	delStruct.setMinSeq = minSeq
+	if delStruct.dryRun {
+		return nil
+	}
 	if remainTime == 0 {
 		err = db.cache.CleanUpOneConversationAllMsg(ctx, conversationID)
 		if err != nil {
@@ -692,12 +1127,42 @@ func (db *commonMsgDatabase) UserMsgsDestruct(ctx context.Context, userID string
 type delMsgRecursionStruct struct {
 	minSeq    int64
 	delDocIDs []string
+	// 被删除文档的归档文件
+	delArchiveKeys []string
+	// 保留策略: seq小于等于maxDelSeq的消息无论是否过期都删除
+	maxDelSeq int64
+	// dryRun只统计将被删除的消息数和minSeq
+	dryRun    bool
+	delMsgNum int64
+	setMinSeq int64
 }
 
 func (d *delMsgRecursionStruct) getSetMinSeq() int64 {
 	return d.minSeq
 }
 
+func (d *delMsgRecursionStruct) needDel(msg *unRelationTb.MsgDataModel, remainTime int64) bool {
+	if msg.Seq <= d.maxDelSeq {
+		return true
+	}
+	return remainTime >= 0 && utils.GetCurrentTimestampByMill() > msg.SendTime+(remainTime*1000)
+}
+
+func (d *delMsgRecursionStruct) deleteDocs(ctx context.Context, msgDocDatabase unRelationTb.MsgDocModelInterface, archive *msgArchive) error {
+	if d.dryRun {
+		return nil
+	}
+	if err := msgDocDatabase.DeleteDocs(ctx, d.delDocIDs); err != nil {
+		return err
+	}
+	if archive != nil && len(d.delArchiveKeys) > 0 {
+		if err := archive.deleteObjects(ctx, d.delArchiveKeys); err != nil {
+			log.ZError(ctx, "delete msg archive objects failed", err, "keys", d.delArchiveKeys)
+		}
+	}
+	return nil
+}
+
 // index 0....19(del) 20...69
 // seq 70
 // set minSeq 21
@@ -714,7 +1179,7 @@ func (db *commonMsgDatabase) deleteMsgRecursion(ctx context.Context, conversatio
 			}
 		}
 		// 获取报错，或者获取不到了，物理删除并且返回seq delMongoMsgsPhysical(delStruct.delDocIDList), 结束递归
-		err = db.msgDocDatabase.DeleteDocs(ctx, delStruct.delDocIDs)
+		err = delStruct.deleteDocs(ctx, db.msgDocDatabase, db.archive)
 		if err != nil {
 			return 0, err
 		}
@@ -724,16 +1189,34 @@ func (db *commonMsgDatabase) deleteMsgRecursion(ctx context.Context, conversatio
 	if int64(len(msgDocModel.Msg)) > db.msg.GetSingleGocMsgNum() {
 		log.ZWarn(ctx, "msgs too large", nil, "lenth", len(msgDocModel.Msg), "docID:", msgDocModel.DocID)
 	}
-	if msgDocModel.IsFull() && msgDocModel.Msg[len(msgDocModel.Msg)-1].Msg.SendTime+(remainTime*1000) < utils.GetCurrentTimestampByMill() {
+	if archive := msgDocModel.Archive; archive != nil {
+		// 归档文档只能整体删除
+		if !delStruct.needDel(&unRelationTb.MsgDataModel{Seq: archive.MaxSeq, SendTime: archive.LastSendTime}, remainTime) {
+			if err := delStruct.deleteDocs(ctx, db.msgDocDatabase, db.archive); err != nil {
+				return 0, err
+			}
+			return archive.MinSeq, nil
+		}
+		log.ZDebug(ctx, "archived doc is expired", "docID", msgDocModel.DocID, "key", archive.Key)
+		delStruct.delDocIDs = append(delStruct.delDocIDs, msgDocModel.DocID)
+		delStruct.delArchiveKeys = append(delStruct.delArchiveKeys, archive.Key)
+		delStruct.minSeq = archive.MaxSeq
+		delStruct.delMsgNum += archive.MsgNum
+	} else if msgDocModel.IsFull() && delStruct.needDel(msgDocModel.Msg[len(msgDocModel.Msg)-1].Msg, remainTime) {
 		log.ZDebug(ctx, "doc is full and all msg is expired", "docID", msgDocModel.DocID)
 		delStruct.delDocIDs = append(delStruct.delDocIDs, msgDocModel.DocID)
 		delStruct.minSeq = msgDocModel.Msg[len(msgDocModel.Msg)-1].Msg.Seq
+		for _, MsgInfoModel := range msgDocModel.Msg {
+			if MsgInfoModel != nil && MsgInfoModel.Msg != nil {
+				delStruct.delMsgNum++
+			}
+		}
 	} else {
 		var hasMarkDelFlag bool
 		var delMsgIndexs []int
 		for i, MsgInfoModel := range msgDocModel.Msg {
 			if MsgInfoModel != nil && MsgInfoModel.Msg != nil {
-				if utils.GetCurrentTimestampByMill() > MsgInfoModel.Msg.SendTime+(remainTime*1000) {
+				if delStruct.needDel(MsgInfoModel.Msg, remainTime) {
 					delMsgIndexs = append(delMsgIndexs, i)
 					hasMarkDelFlag = true
 				} else {
@@ -741,14 +1224,17 @@ func (db *commonMsgDatabase) deleteMsgRecursion(ctx context.Context, conversatio
 					if len(delStruct.delDocIDs) > 0 {
 						log.ZDebug(ctx, "delete docs", "delDocIDs", delStruct.delDocIDs)
 					}
-					if err := db.msgDocDatabase.DeleteDocs(ctx, delStruct.delDocIDs); err != nil {
+					if err := delStruct.deleteDocs(ctx, db.msgDocDatabase, db.archive); err != nil {
 						return 0, err
 					}
 					if hasMarkDelFlag {
 						log.ZDebug(ctx, "delete msg by index", "delMsgIndexs", delMsgIndexs, "docID", msgDocModel.DocID)
+						delStruct.delMsgNum += int64(len(delMsgIndexs))
 						// mark del all delMsgIndexs
-						if err := db.msgDocDatabase.DeleteMsgsInOneDocByIndex(ctx, msgDocModel.DocID, delMsgIndexs); err != nil {
-							return delStruct.getSetMinSeq(), err
+						if !delStruct.dryRun {
+							if err := db.msgDocDatabase.DeleteMsgsInOneDocByIndex(ctx, msgDocModel.DocID, delMsgIndexs); err != nil {
+								return delStruct.getSetMinSeq(), err
+							}
 						}
 					}
 					return MsgInfoModel.Msg.Seq, nil
@@ -888,6 +1374,38 @@ func (db *commonMsgDatabase) GetHasReadSeq(ctx context.Context, userID string, c
 	return db.cache.GetHasReadSeq(ctx, userID, conversationID)
 }
 
+func (db *commonMsgDatabase) GetConversationUsersHasReadSeqs(ctx context.Context, conversationID string, userIDs []string) (map[string]int64, error) {
+	return db.cache.GetConversationUsersHasReadSeqs(ctx, conversationID, userIDs)
+}
+
+func (db *commonMsgDatabase) SetThreadHasReadSeq(ctx context.Context, userID string, conversationID string, rootSeq int64, hasReadSeq int64) error {
+	return db.cache.SetHasReadSeq(ctx, userID, utils.GetThreadConversationID(conversationID, rootSeq), hasReadSeq)
+}
+
+func (db *commonMsgDatabase) GetThreadHasReadSeqs(ctx context.Context, userID string, conversationID string, rootSeqs []int64) (map[int64]int64, error) {
+	threadIDs := make(map[string]int64, len(rootSeqs))
+	for _, rootSeq := range rootSeqs {
+		threadIDs[utils.GetThreadConversationID(conversationID, rootSeq)] = rootSeq
+	}
+	seqs, err := db.cache.GetHasReadSeqs(ctx, userID, utils.Keys(threadIDs))
+	if err != nil {
+		return nil, err
+	}
+	hasReadSeqs := make(map[int64]int64, len(seqs))
+	for threadID, seq := range seqs {
+		hasReadSeqs[threadIDs[threadID]] = seq
+	}
+	return hasReadSeqs, nil
+}
+
+func (db *commonMsgDatabase) GetThreads(ctx context.Context, conversationID string, rootSeqs []int64) ([]*unRelationTb.ThreadModel, error) {
+	return db.threadDatabase.Find(ctx, conversationID, rootSeqs)
+}
+
+func (db *commonMsgDatabase) GetThreadReplySeqs(ctx context.Context, conversationID string, rootSeq int64, begin, end int64) ([]int64, error) {
+	return db.threadDatabase.GetReplySeqs(ctx, conversationID, rootSeq, begin, end)
+}
+
 func (db *commonMsgDatabase) SetSendMsgStatus(ctx context.Context, id string, status int32) error {
 	return db.cache.SetSendMsgStatus(ctx, id, status)
 }
@@ -896,6 +1414,43 @@ func (db *commonMsgDatabase) GetSendMsgStatus(ctx context.Context, id string) (i
 	return db.cache.GetSendMsgStatus(ctx, id)
 }
 
+func (db *commonMsgDatabase) InitMsgDeliveryStatus(ctx context.Context, msg *sdkws.MsgData) error {
+	target := &cache.MsgDeliveryTarget{SendID: msg.SendID}
+	if msg.SessionType == constant.SingleChatType {
+		target.RecvID = msg.RecvID
+	} else {
+		target.GroupID = msg.GroupID
+	}
+	return db.cache.InitMsgDeliveryStatus(ctx, msg.ServerMsgID, target, time.Now().UnixMilli())
+}
+
+func (db *commonMsgDatabase) GetMsgsDeliveryTargets(ctx context.Context, serverMsgIDs []string) (map[string]*cache.MsgDeliveryTarget, error) {
+	return db.cache.GetMsgsDeliveryTargets(ctx, utils.Distinct(serverMsgIDs))
+}
+
+func (db *commonMsgDatabase) SetMsgsPersisted(ctx context.Context, msgs []*sdkws.MsgData) error {
+	var serverMsgIDs []string
+	for _, msg := range msgs {
+		// 只有开启投递回执的消息才会被跟踪
+		if utils.Options(msg.Options).IsDeliveryReceipt() {
+			serverMsgIDs = append(serverMsgIDs, msg.ServerMsgID)
+		}
+	}
+	if len(serverMsgIDs) == 0 {
+		return nil
+	}
+	serverMsgIDs = utils.Distinct(serverMsgIDs)
+	return db.cache.SetMsgsDeliveryState(ctx, serverMsgIDs, "", constant.MsgDeliveryPersisted, time.Now().UnixMilli())
+}
+
+func (db *commonMsgDatabase) AckMsgsDelivered(ctx context.Context, userID string, serverMsgIDs []string) error {
+	return db.cache.AckMsgsDelivered(ctx, userID, utils.Distinct(serverMsgIDs), time.Now().UnixMilli())
+}
+
+func (db *commonMsgDatabase) GetMsgDeliveryStatus(ctx context.Context, serverMsgID string) (string, map[string]map[int32]int64, error) {
+	return db.cache.GetMsgDeliveryStatus(ctx, serverMsgID)
+}
+
 func (db *commonMsgDatabase) GetConversationMinMaxSeqInMongoAndCache(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo, minSeqCache, maxSeqCache int64, err error) {
 	minSeqMongo, maxSeqMongo, err = db.GetMinMaxSeqMongo(ctx, conversationID)
 	if err != nil {
@@ -912,6 +1467,13 @@ func (db *commonMsgDatabase) GetConversationMinMaxSeqInMongoAndCache(ctx context
 	return
 }
 
+func (db *commonMsgDatabase) ArchiveMsgDocs(ctx context.Context, before time.Time, limit int) (int, error) {
+	if db.archive == nil {
+		return 0, errs.ErrArgs.Wrap("msg archive not enabled")
+	}
+	return db.archive.archiveDocs(ctx, before, limit)
+}
+
 func (db *commonMsgDatabase) GetMongoMaxAndMinSeq(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo int64, err error) {
 	return db.GetMinMaxSeqMongo(ctx, conversationID)
 }
@@ -954,6 +1516,9 @@ func (db *commonMsgDatabase) RangeGroupSendCount(
 }
 
 func (db *commonMsgDatabase) SearchMessage(ctx context.Context, req *pbMsg.SearchMessageReq) (total int32, msgData []*sdkws.MsgData, err error) {
+	if req.Keyword != "" {
+		return db.searchMessageByIndex(ctx, req)
+	}
 	var totalMsgs []*sdkws.MsgData
 	total, msgs, err := db.msgDocDatabase.SearchMessage(ctx, req)
 	if err != nil {
@@ -964,3 +1529,117 @@ func (db *commonMsgDatabase) SearchMessage(ctx context.Context, req *pbMsg.Searc
 	}
 	return total, totalMsgs, nil
 }
+
+// searchMessageByIndex checks every hit against the stored msg, so msgs deleted for the user, revoked
+// or edited after being indexed are not returned. Hits are checked batch by batch until the requested page is filled,
+// at most searchMaxScanHits hits are checked. total is exact when all hits are checked, otherwise it is estimated
+// as the visible msgs found plus the unchecked hits.
+func (db *commonMsgDatabase) searchMessageByIndex(ctx context.Context, req *pbMsg.SearchMessageReq) (int32, []*sdkws.MsgData, error) {
+	if db.searchIndex == nil {
+		return 0, nil, errs.ErrArgs.Wrap("msg search is not enabled")
+	}
+	searchReq := &search.SearchReq{
+		Keyword:         req.Keyword,
+		ConversationIDs: req.ConversationIDs,
+		SendID:          req.SendID,
+		RecvID:          req.RecvID,
+		SessionType:     req.SessionType,
+		ContentType:     req.MsgType,
+	}
+	if req.SendTime != "" {
+		day, err := time.Parse("2006-01-02", req.SendTime)
+		if err != nil {
+			return 0, nil, errs.ErrArgs.Wrap("sendTime should be like 2006-01-02")
+		}
+		searchReq.StartTime = day.UnixMilli()
+		searchReq.EndTime = day.AddDate(0, 0, 1).UnixMilli()
+	}
+	// 只校验到请求的页为止
+	need := searchMaxScanHits
+	if req.Pagination != nil && req.Pagination.ShowNumber > 0 && req.Pagination.PageNumber > 0 {
+		need = int(req.Pagination.PageNumber * req.Pagination.ShowNumber)
+	}
+	var (
+		visibleMsgs []*sdkws.MsgData
+		hitNum      int
+	)
+	for len(visibleMsgs) < need && searchReq.Offset < searchMaxScanHits {
+		searchReq.Limit = need - len(visibleMsgs)
+		if searchReq.Limit > searchHitsBatch {
+			searchReq.Limit = searchHitsBatch
+		}
+		var (
+			hits []*search.Hit
+			err  error
+		)
+		hitNum, hits, err = db.searchIndex.Search(ctx, searchReq)
+		if err != nil {
+			return 0, nil, err
+		}
+		msgs, err := db.visibleSearchHits(ctx, req, hits)
+		if err != nil {
+			return 0, nil, err
+		}
+		visibleMsgs = append(visibleMsgs, msgs...)
+		searchReq.Offset += len(hits)
+		if len(hits) == 0 || searchReq.Offset >= hitNum {
+			break
+		}
+	}
+	total := int32(len(visibleMsgs))
+	if hitNum > searchReq.Offset {
+		total += int32(hitNum - searchReq.Offset)
+	}
+	if req.Pagination != nil && req.Pagination.ShowNumber > 0 {
+		start := int((req.Pagination.PageNumber - 1) * req.Pagination.ShowNumber)
+		if start < 0 || start >= len(visibleMsgs) {
+			return total, nil, nil
+		}
+		end := start + int(req.Pagination.ShowNumber)
+		if end > len(visibleMsgs) {
+			end = len(visibleMsgs)
+		}
+		visibleMsgs = visibleMsgs[start:end]
+	}
+	return total, visibleMsgs, nil
+}
+
+// visibleSearchHits returns the msgs of hits which are still visible to req.UserID and still match the keyword, in hit order.
+func (db *commonMsgDatabase) visibleSearchHits(ctx context.Context, req *pbMsg.SearchMessageReq, hits []*search.Hit) ([]*sdkws.MsgData, error) {
+	conversationSeqs := make(map[string][]int64)
+	for _, hit := range hits {
+		conversationSeqs[hit.ConversationID] = append(conversationSeqs[hit.ConversationID], hit.Seq)
+	}
+	msgMap := make(map[string]map[int64]*sdkws.MsgData, len(conversationSeqs))
+	for conversationID, seqs := range conversationSeqs {
+		var (
+			msgs []*sdkws.MsgData
+			err  error
+		)
+		if req.UserID == "" {
+			msgs, err = db.getMsgBySeqs(ctx, "", conversationID, seqs)
+		} else {
+			_, _, msgs, err = db.GetMsgBySeqs(ctx, req.UserID, conversationID, seqs)
+		}
+		if err != nil {
+			return nil, err
+		}
+		msgMap[conversationID] = make(map[int64]*sdkws.MsgData, len(msgs))
+		for _, msg := range msgs {
+			if msg == nil || msg.ContentType == constant.MsgRevokeNotification {
+				continue
+			}
+			if !search.Match(req.Keyword, msg.Content) {
+				continue
+			}
+			msgMap[conversationID][msg.Seq] = msg
+		}
+	}
+	var visibleMsgs []*sdkws.MsgData
+	for _, hit := range hits {
+		if msg, ok := msgMap[hit.ConversationID][hit.Seq]; ok {
+			visibleMsgs = append(visibleMsgs, msg)
+		}
+	}
+	return visibleMsgs, nil
+}
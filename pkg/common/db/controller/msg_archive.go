@@ -0,0 +1,319 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bytes"
+	"compress/gzip"
+	"container/list"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3"
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const (
+	msgArchivePrefix      = "msg_archive"
+	msgArchiveURLExpire   = time.Hour
+	msgArchiveHTTPTimeout = time.Minute
+)
+
+// msgArchiveFile 归档文件内容, bson编码后gzip压缩.
+type msgArchiveFile struct {
+	DocID string                       `bson:"doc_id"`
+	Msgs  []*unRelationTb.MsgInfoModel `bson:"msgs"`
+}
+
+type msgArchiveEntry struct {
+	key  string
+	msgs map[int64]*unRelationTb.MsgInfoModel
+}
+
+// msgArchive 将写满的冷文档归档到对象存储, 读取时按文档缓存最近使用的归档.
+type msgArchive struct {
+	s3        s3.Interface
+	docs      unRelationTb.MsgDocModelInterface
+	client    *http.Client
+	cacheSize int
+	lock      sync.Mutex
+	lru       *list.List
+	entries   map[string]*list.Element
+}
+
+func newMsgArchive(o s3.Interface, docs unRelationTb.MsgDocModelInterface, cacheSize int) *msgArchive {
+	return &msgArchive{
+		s3:        o,
+		docs:      docs,
+		client:    &http.Client{Timeout: msgArchiveHTTPTimeout},
+		cacheSize: cacheSize,
+		lru:       list.New(),
+		entries:   make(map[string]*list.Element),
+	}
+}
+
+func (a *msgArchive) key(docID string) string {
+	i := strings.LastIndex(docID, ":")
+	if i < 0 {
+		return msgArchivePrefix + "/" + docID + ".bson.gz"
+	}
+	return msgArchivePrefix + "/" + docID[:i] + "/" + docID[i+1:] + ".bson.gz"
+}
+
+func encodeMsgArchive(file *msgArchiveFile) ([]byte, error) {
+	data, err := bson.Marshal(file)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeMsgArchive(data []byte) (*msgArchiveFile, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer r.Close()
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var file msgArchiveFile
+	if err := bson.Unmarshal(raw, &file); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &file, nil
+}
+
+// archiveDocs 归档最后一条消息早于before的已写满文档, 返回归档的文档数.
+func (a *msgArchive) archiveDocs(ctx context.Context, before time.Time, limit int) (int, error) {
+	docIDs, err := a.docs.FindArchivableDocIDs(ctx, before.UnixMilli(), int64(limit))
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	for _, docID := range docIDs {
+		ok, err := a.archiveDoc(ctx, docID)
+		if err != nil {
+			log.ZError(ctx, "archive msg doc failed", err, "docID", docID)
+			continue
+		}
+		if ok {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (a *msgArchive) archiveDoc(ctx context.Context, docID string) (bool, error) {
+	doc, err := a.docs.FindOneByDocID(ctx, docID)
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	if doc.Archive != nil || len(doc.Msg) == 0 || !doc.IsFull() {
+		return false, nil
+	}
+	archive := &unRelationTb.MsgDocArchiveModel{Key: a.key(docID), ArchiveTime: time.Now().UnixMilli()}
+	file := &msgArchiveFile{DocID: docID}
+	for _, msg := range doc.Msg {
+		if msg == nil || msg.Msg == nil {
+			continue
+		}
+		if archive.MinSeq == 0 || msg.Msg.Seq < archive.MinSeq {
+			archive.MinSeq = msg.Msg.Seq
+		}
+		if msg.Msg.Seq > archive.MaxSeq {
+			archive.MaxSeq = msg.Msg.Seq
+		}
+		if msg.Msg.SendTime > archive.LastSendTime {
+			archive.LastSendTime = msg.Msg.SendTime
+		}
+		file.Msgs = append(file.Msgs, msg)
+	}
+	// 消息已全部删除的文档由清理任务删除
+	if len(file.Msgs) == 0 {
+		return false, nil
+	}
+	archive.MsgNum = int64(len(file.Msgs))
+	data, err := encodeMsgArchive(file)
+	if err != nil {
+		return false, err
+	}
+	archive.Size = int64(len(data))
+	if err := a.put(ctx, archive.Key, data); err != nil {
+		return false, err
+	}
+	// 上传期间文档被修改时不清空msgs, 已上传的归档文件未被引用, 下次归档时重新上传覆盖
+	ok, err := a.docs.ArchiveDoc(ctx, docID, doc.Version, archive)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		log.ZInfo(ctx, "msg doc changed during archive, retry later", "docID", docID, "version", doc.Version)
+		return false, nil
+	}
+	log.ZInfo(ctx, "msg doc archived", "docID", docID, "key", archive.Key, "msgNum", archive.MsgNum, "size", archive.Size)
+	return true, nil
+}
+
+func (a *msgArchive) put(ctx context.Context, key string, data []byte) error {
+	u, err := a.s3.PresignedPutObject(ctx, key, msgArchiveURLExpire)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u, bytes.NewReader(data))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.ContentLength = int64(len(data))
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errs.Wrap(fmt.Errorf("put %s status %d: %s", key, resp.StatusCode, body))
+	}
+	info, err := a.s3.StatObject(ctx, key)
+	if err != nil {
+		return err
+	}
+	if info.Size != int64(len(data)) {
+		return errs.Wrap(fmt.Errorf("put %s size mismatch %d != %d", key, info.Size, len(data)))
+	}
+	return nil
+}
+
+func (a *msgArchive) get(ctx context.Context, key string) ([]byte, error) {
+	u, err := a.s3.AccessURL(ctx, key, msgArchiveURLExpire, nil)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errs.Wrap(fmt.Errorf("get %s status %d", key, resp.StatusCode))
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return data, nil
+}
+
+func (a *msgArchive) load(ctx context.Context, key string) (map[int64]*unRelationTb.MsgInfoModel, error) {
+	a.lock.Lock()
+	if elem, ok := a.entries[key]; ok {
+		a.lru.MoveToFront(elem)
+		a.lock.Unlock()
+		return elem.Value.(*msgArchiveEntry).msgs, nil
+	}
+	a.lock.Unlock()
+	data, err := a.get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := decodeMsgArchive(data)
+	if err != nil {
+		return nil, err
+	}
+	msgs := make(map[int64]*unRelationTb.MsgInfoModel, len(file.Msgs))
+	for _, msg := range file.Msgs {
+		if msg != nil && msg.Msg != nil {
+			msgs[msg.Msg.Seq] = msg
+		}
+	}
+	a.add(key, msgs)
+	return msgs, nil
+}
+
+func (a *msgArchive) add(key string, msgs map[int64]*unRelationTb.MsgInfoModel) {
+	if a.cacheSize <= 0 {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if elem, ok := a.entries[key]; ok {
+		a.lru.MoveToFront(elem)
+		return
+	}
+	a.entries[key] = a.lru.PushFront(&msgArchiveEntry{key: key, msgs: msgs})
+	for a.lru.Len() > a.cacheSize {
+		elem := a.lru.Back()
+		a.lru.Remove(elem)
+		delete(a.entries, elem.Value.(*msgArchiveEntry).key)
+	}
+}
+
+// findMsgs 从归档中读取seqs对应的消息, 过滤userID已删除的消息, 撤回的消息替换为撤回通知.
+func (a *msgArchive) findMsgs(ctx context.Context, userID string, archive *unRelationTb.MsgDocArchiveModel, seqs []int64) ([]*unRelationTb.MsgInfoModel, error) {
+	msgs, err := a.load(ctx, archive.Key)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*unRelationTb.MsgInfoModel, 0, len(seqs))
+	for _, seq := range seqs {
+		msg, ok := msgs[seq]
+		if !ok || utils.IsContain(userID, msg.DelList) {
+			continue
+		}
+		// 缓存中的消息共享, 修改前复制
+		info := *msg
+		data := *msg.Msg
+		info.Msg = &data
+		info.DelList = nil
+		if err := unrelation.ConvertRevokeMsg(&info); err != nil {
+			return nil, err
+		}
+		res = append(res, &info)
+	}
+	return res, nil
+}
+
+func (a *msgArchive) deleteObjects(ctx context.Context, keys []string) error {
+	for _, key := range keys {
+		if err := a.s3.DeleteObject(ctx, key); err != nil && !a.s3.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
+
+// archivedDocs 模拟mongo中已归档的文档: 按下标修改匹配不到, 插入同docID重复.
+type archivedDocs struct {
+	unRelationTb.MsgDocModelInterface
+	creates int
+}
+
+func (d *archivedDocs) UpdateMsg(context.Context, string, int64, string, any) (*mongo.UpdateResult, error) {
+	return &mongo.UpdateResult{}, nil
+}
+
+func (d *archivedDocs) Create(context.Context, *unRelationTb.MsgDocModel) error {
+	d.creates++
+	return mongo.WriteException{WriteErrors: []mongo.WriteError{{Code: 11000}}}
+}
+
+func (d *archivedDocs) GetDocArchive(context.Context, string) (*unRelationTb.MsgDocArchiveModel, error) {
+	return &unRelationTb.MsgDocArchiveModel{Key: "msg_archive/si_a_b/0.bson.gz"}, nil
+}
+
+func TestRevokeArchivedMsg(t *testing.T) {
+	docs := &archivedDocs{}
+	db := &commonMsgDatabase{msgDocDatabase: docs}
+	err := db.RevokeMsg(context.Background(), "si_a_b", 10, &unRelationTb.RevokeModel{UserID: "a"})
+	if !errs.ErrMsgArchived.Is(err) {
+		t.Fatal("expected archived error", err)
+	}
+	if docs.creates != 2 {
+		t.Fatal("unexpected create times", docs.creates)
+	}
+}
+
+func TestMsgArchive(t *testing.T) {
+	a := newMsgArchive(nil, nil, 1)
+	key := a.key("si_a_b:3")
+	if key != "msg_archive/si_a_b/3.bson.gz" {
+		t.Fatal("unexpected key", key)
+	}
+	data, err := encodeMsgArchive(&msgArchiveFile{DocID: "si_a_b:3", Msgs: []*unRelationTb.MsgInfoModel{
+		{Msg: &unRelationTb.MsgDataModel{Seq: 15001, Content: "hello"}},
+		{Msg: &unRelationTb.MsgDataModel{Seq: 15002, Content: "deleted"}, DelList: []string{"a"}},
+		{Msg: &unRelationTb.MsgDataModel{Seq: 15003, Content: "revoked"}, Revoke: &unRelationTb.RevokeModel{UserID: "b"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := decodeMsgArchive(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.DocID != "si_a_b:3" || len(file.Msgs) != 3 {
+		t.Fatal("unexpected file", file.DocID, len(file.Msgs))
+	}
+	msgs := make(map[int64]*unRelationTb.MsgInfoModel)
+	for _, msg := range file.Msgs {
+		msgs[msg.Msg.Seq] = msg
+	}
+	a.add(key, msgs)
+	res, err := a.findMsgs(context.Background(), "a", &unRelationTb.MsgDocArchiveModel{Key: key}, []int64{15001, 15002, 15003, 15004})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 || res[0].Msg.Content != "hello" || res[1].Msg.ContentType != constant.MsgRevokeNotification {
+		t.Fatal("unexpected msgs", res)
+	}
+	// 缓存中的消息不能被撤回转换修改
+	if msgs[15003].Msg.Content != "revoked" {
+		t.Fatal("cached msg modified")
+	}
+	a.add("msg_archive/si_a_b/4.bson.gz", nil)
+	if _, ok := a.entries[key]; ok || a.lru.Len() != 1 {
+		t.Fatal("lru not evicted")
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+type dedupCache struct {
+	cache.MsgModel
+	seqs map[string]int64
+}
+
+func (c *dedupCache) GetClientMsgSeqs(_ context.Context, _ string, clientMsgIDs []string) (map[string]int64, error) {
+	res := make(map[string]int64)
+	for _, id := range clientMsgIDs {
+		if seq, ok := c.seqs[id]; ok {
+			res[id] = seq
+		}
+	}
+	return res, nil
+}
+
+func TestSkipDuplicateMsgs(t *testing.T) {
+	defer func(window int) { config.Config.MsgDedupWindow = window }(config.Config.MsgDedupWindow)
+	config.Config.MsgDedupWindow = 60
+	db := &commonMsgDatabase{cache: &dedupCache{seqs: map[string]int64{"a": 10}}}
+	msgs := []*sdkws.MsgData{{ClientMsgID: "a"}, {ClientMsgID: "b"}, {ClientMsgID: "b"}, {}, {}}
+	res, err := db.skipDuplicateMsgs(context.Background(), "si_1_2", msgs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a已经分配过seq, 批次内重复的b只保留一条, 没有clientMsgID的消息不去重
+	if len(res) != 3 || res[0] != msgs[1] || res[1] != msgs[3] || res[2] != msgs[4] {
+		t.Fatalf("unexpected result %v", res)
+	}
+
+	config.Config.MsgDedupWindow = 0
+	if res, _ := db.skipDuplicateMsgs(context.Background(), "si_1_2", msgs); len(res) != len(msgs) {
+		t.Fatalf("dedup disabled, got %d msgs", len(res))
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
+
+type PinnedMsgDatabase interface {
+	// 超过maxNum时返回错误
+	PinMsg(ctx context.Context, pinnedMsg *unRelationTb.PinnedMsgModel, maxNum int64) error
+	UnpinMsg(ctx context.Context, conversationID string, seq int64) error
+	GetPinnedMsgs(ctx context.Context, conversationID string) ([]*unRelationTb.PinnedMsgModel, error)
+}
+
+type pinnedMsgDatabase struct {
+	pinnedMsg unRelationTb.PinnedMsgModelInterface
+}
+
+func NewPinnedMsgDatabase(pinnedMsg unRelationTb.PinnedMsgModelInterface) PinnedMsgDatabase {
+	return &pinnedMsgDatabase{pinnedMsg: pinnedMsg}
+}
+
+func (p *pinnedMsgDatabase) PinMsg(ctx context.Context, pinnedMsg *unRelationTb.PinnedMsgModel, maxNum int64) error {
+	ok, err := p.pinnedMsg.IncrCount(ctx, pinnedMsg.ConversationID, maxNum)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrArgs.Wrap("too many pinned msgs in the conversation")
+	}
+	if err := p.pinnedMsg.Create(ctx, pinnedMsg); err != nil {
+		if decrErr := p.pinnedMsg.DecrCount(ctx, pinnedMsg.ConversationID); decrErr != nil {
+			log.ZWarn(ctx, "DecrCount failed", decrErr, "conversationID", pinnedMsg.ConversationID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (p *pinnedMsgDatabase) UnpinMsg(ctx context.Context, conversationID string, seq int64) error {
+	ok, err := p.pinnedMsg.Delete(ctx, conversationID, seq)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrRecordNotFound.Wrap("msg not pinned")
+	}
+	return p.pinnedMsg.DecrCount(ctx, conversationID)
+}
+
+func (p *pinnedMsgDatabase) GetPinnedMsgs(ctx context.Context, conversationID string) ([]*unRelationTb.PinnedMsgModel, error) {
+	return p.pinnedMsg.Find(ctx, conversationID)
+}
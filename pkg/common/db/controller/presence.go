@@ -0,0 +1,128 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+type PresenceDatabase interface {
+	SetUserCustomPresence(ctx context.Context, userID string, customState int32, statusText string) error
+	// GetUsersPresence 在线平台来自网关的路由表, 已停止心跳的网关上的路由不计入
+	GetUsersPresence(ctx context.Context, userIDs []string) ([]*sdkws.UserPresence, error)
+	// PublishUsersPresence 读取用户当前的状态并广播给所有网关
+	PublishUsersPresence(ctx context.Context, userIDs []string) error
+	// SubscribeUsersPresence 接收任意网关或服务广播的用户状态, ctx结束后停止
+	SubscribeUsersPresence(ctx context.Context) <-chan *sdkws.UserPresence
+}
+
+type presenceDatabase struct {
+	cache cache.MsgModel
+}
+
+func NewPresenceDatabase(cache cache.MsgModel) PresenceDatabase {
+	return &presenceDatabase{cache: cache}
+}
+
+func (p *presenceDatabase) SetUserCustomPresence(ctx context.Context, userID string, customState int32, statusText string) error {
+	return p.cache.SetUserCustomPresence(ctx, userID, customState, statusText)
+}
+
+func (p *presenceDatabase) GetUsersPresence(ctx context.Context, userIDs []string) ([]*sdkws.UserPresence, error) {
+	userIDs = utils.Distinct(userIDs)
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	routes, err := p.cache.GetUsersRoutes(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	var gatewayAddrs []string
+	for _, gateways := range routes {
+		for gatewayAddr := range gateways {
+			gatewayAddrs = append(gatewayAddrs, gatewayAddr)
+		}
+	}
+	alive, err := p.cache.GetAliveGateways(ctx, utils.Distinct(gatewayAddrs))
+	if err != nil {
+		return nil, err
+	}
+	aliveMap := utils.SliceSet(alive)
+	customs, err := p.cache.GetUsersCustomPresence(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	presences := make([]*sdkws.UserPresence, 0, len(userIDs))
+	for _, userID := range userIDs {
+		presence := &sdkws.UserPresence{UserID: userID, Status: constant.PresenceOffline}
+		if custom, ok := customs[userID]; ok {
+			presence.CustomState = custom.CustomState
+			presence.StatusText = custom.StatusText
+		}
+		var platformIDs []int32
+		for gatewayAddr, ids := range routes[userID] {
+			if _, ok := aliveMap[gatewayAddr]; !ok {
+				continue
+			}
+			for _, id := range ids {
+				platformIDs = append(platformIDs, int32(id))
+			}
+		}
+		if len(platformIDs) > 0 {
+			presence.Status = constant.PresenceOnline
+			presence.PlatformIDs = utils.Distinct(platformIDs)
+		}
+		presences = append(presences, presence)
+	}
+	return presences, nil
+}
+
+func (p *presenceDatabase) PublishUsersPresence(ctx context.Context, userIDs []string) error {
+	presences, err := p.GetUsersPresence(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	for _, presence := range presences {
+		if err := p.cache.PublishUserPresence(ctx, presence); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *presenceDatabase) SubscribeUsersPresence(ctx context.Context) <-chan *sdkws.UserPresence {
+	msgs := p.cache.SubscribeUserPresence(ctx)
+	ch := make(chan *sdkws.UserPresence, 100)
+	go func() {
+		defer close(ch)
+		for msg := range msgs {
+			var presence sdkws.UserPresence
+			if err := proto.Unmarshal([]byte(msg.Payload), &presence); err != nil {
+				log.ZWarn(ctx, "unmarshal user presence failed", err, "payload", msg.Payload)
+				continue
+			}
+			ch <- &presence
+		}
+	}()
+	return ch
+}
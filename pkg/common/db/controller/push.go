@@ -16,12 +16,17 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
 )
 
 type PushDatabase interface {
 	DelFcmToken(ctx context.Context, userID string, platformID int) error
+	SetMsgDeliveryUsersState(ctx context.Context, serverMsgID string, userIDs []string, state int32) error
+	// k: userID, v: gatewayAddr -> platformIDs
+	GetUsersOnlineRoutes(ctx context.Context, userIDs []string) (map[string]map[string][]int, error)
+	GetAliveGateways(ctx context.Context, gatewayAddrs []string) ([]string, error)
 }
 
 type pushDataBase struct {
@@ -35,3 +40,15 @@ func NewPushDatabase(cache cache.MsgModel) PushDatabase {
 func (p *pushDataBase) DelFcmToken(ctx context.Context, userID string, platformID int) error {
 	return p.cache.DelFcmToken(ctx, userID, platformID)
 }
+
+func (p *pushDataBase) SetMsgDeliveryUsersState(ctx context.Context, serverMsgID string, userIDs []string, state int32) error {
+	return p.cache.SetMsgDeliveryUsersState(ctx, serverMsgID, userIDs, state, time.Now().UnixMilli())
+}
+
+func (p *pushDataBase) GetUsersOnlineRoutes(ctx context.Context, userIDs []string) (map[string]map[string][]int, error) {
+	return p.cache.GetUsersRoutes(ctx, userIDs)
+}
+
+func (p *pushDataBase) GetAliveGateways(ctx context.Context, gatewayAddrs []string) ([]string, error) {
+	return p.cache.GetAliveGateways(ctx, gatewayAddrs)
+}
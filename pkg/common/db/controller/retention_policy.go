@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
+
+type RetentionPolicyDatabase interface {
+	SetRetentionPolicy(ctx context.Context, policy *unRelationTb.RetentionPolicyModel) error
+	DeleteRetentionPolicy(ctx context.Context, targetType int32, targetID string) error
+	PageRetentionPolicies(ctx context.Context, targetType int32, pageNumber, showNumber int32) (int64, []*unRelationTb.RetentionPolicyModel, error)
+	GetAllRetentionPolicies(ctx context.Context) ([]*unRelationTb.RetentionPolicyModel, error)
+}
+
+type retentionPolicyDatabase struct {
+	retentionPolicy unRelationTb.RetentionPolicyModelInterface
+}
+
+func NewRetentionPolicyDatabase(retentionPolicy unRelationTb.RetentionPolicyModelInterface) RetentionPolicyDatabase {
+	return &retentionPolicyDatabase{retentionPolicy: retentionPolicy}
+}
+
+func (r *retentionPolicyDatabase) SetRetentionPolicy(ctx context.Context, policy *unRelationTb.RetentionPolicyModel) error {
+	return r.retentionPolicy.Upsert(ctx, policy)
+}
+
+func (r *retentionPolicyDatabase) DeleteRetentionPolicy(ctx context.Context, targetType int32, targetID string) error {
+	ok, err := r.retentionPolicy.Delete(ctx, targetType, targetID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrRecordNotFound.Wrap("retention policy not found")
+	}
+	return nil
+}
+
+func (r *retentionPolicyDatabase) PageRetentionPolicies(
+	ctx context.Context,
+	targetType int32,
+	pageNumber, showNumber int32,
+) (int64, []*unRelationTb.RetentionPolicyModel, error) {
+	return r.retentionPolicy.Find(ctx, targetType, pageNumber, showNumber)
+}
+
+func (r *retentionPolicyDatabase) GetAllRetentionPolicies(ctx context.Context) ([]*unRelationTb.RetentionPolicyModel, error) {
+	_, policies, err := r.retentionPolicy.Find(ctx, 0, 0, 0)
+	return policies, err
+}
@@ -0,0 +1,119 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	unRelationTb "github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const (
+	// 定时消息发送中的租约时间, 超时未写回结果的消息会被重新发送, 重复发送由clientMsgID去重.
+	scheduledMsgSendLease = time.Minute * 5
+	// 发送遇到可重试的错误时最多发送的次数, 以及第n次失败后等待n倍的重试间隔
+	scheduledMsgMaxAttempts   = 5
+	scheduledMsgRetryInterval = time.Second * 30
+)
+
+type ScheduledMsgDatabase interface {
+	CreateScheduledMsg(ctx context.Context, msg *unRelationTb.ScheduledMsgModel) error
+	TakeScheduledMsg(ctx context.Context, scheduledMsgID string) (*unRelationTb.ScheduledMsgModel, error)
+	PageScheduledMsgs(ctx context.Context, sendID string, status []int32, pageNumber, showNumber int32) (int64, []*unRelationTb.ScheduledMsgModel, error)
+	// 仅可修改未发送的定时消息
+	UpdatePendingScheduledMsg(ctx context.Context, scheduledMsgID string, update map[string]any) error
+	CancelScheduledMsg(ctx context.Context, scheduledMsgID string) error
+	// 取出一条到期或发送租约已过期的定时消息并标记为发送中, 没有则返回nil
+	TakeDueScheduledMsg(ctx context.Context, now int64) (*unRelationTb.ScheduledMsgModel, error)
+	// 写回发送结果, retry为true且未超过最大发送次数时等待重试间隔后重新发送, 租约已被其他实例取得时不修改
+	SetScheduledMsgResult(ctx context.Context, msg *unRelationTb.ScheduledMsgModel, serverMsgID string, sendErr error, retry bool) error
+}
+
+type scheduledMsgDatabase struct {
+	scheduledMsg unRelationTb.ScheduledMsgModelInterface
+}
+
+func NewScheduledMsgDatabase(scheduledMsg unRelationTb.ScheduledMsgModelInterface) ScheduledMsgDatabase {
+	return &scheduledMsgDatabase{scheduledMsg: scheduledMsg}
+}
+
+func (s *scheduledMsgDatabase) CreateScheduledMsg(ctx context.Context, msg *unRelationTb.ScheduledMsgModel) error {
+	return s.scheduledMsg.Create(ctx, msg)
+}
+
+func (s *scheduledMsgDatabase) TakeScheduledMsg(ctx context.Context, scheduledMsgID string) (*unRelationTb.ScheduledMsgModel, error) {
+	return s.scheduledMsg.Take(ctx, scheduledMsgID)
+}
+
+func (s *scheduledMsgDatabase) PageScheduledMsgs(
+	ctx context.Context,
+	sendID string,
+	status []int32,
+	pageNumber, showNumber int32,
+) (int64, []*unRelationTb.ScheduledMsgModel, error) {
+	return s.scheduledMsg.FindBySendID(ctx, sendID, status, pageNumber, showNumber)
+}
+
+func (s *scheduledMsgDatabase) UpdatePendingScheduledMsg(ctx context.Context, scheduledMsgID string, update map[string]any) error {
+	ok, err := s.scheduledMsg.UpdatePending(ctx, scheduledMsgID, update)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrRecordNotFound.Wrap("scheduled msg is not pending")
+	}
+	return nil
+}
+
+func (s *scheduledMsgDatabase) CancelScheduledMsg(ctx context.Context, scheduledMsgID string) error {
+	return s.UpdatePendingScheduledMsg(ctx, scheduledMsgID, map[string]any{"status": constant.ScheduledMsgCanceled})
+}
+
+func (s *scheduledMsgDatabase) TakeDueScheduledMsg(ctx context.Context, now int64) (*unRelationTb.ScheduledMsgModel, error) {
+	return s.scheduledMsg.TakeDue(ctx, now, scheduledMsgSendLease, utils.OperationIDGenerator())
+}
+
+func (s *scheduledMsgDatabase) SetScheduledMsgResult(
+	ctx context.Context,
+	msg *unRelationTb.ScheduledMsgModel,
+	serverMsgID string,
+	sendErr error,
+	retry bool,
+) error {
+	var (
+		ok  bool
+		err error
+	)
+	switch {
+	case sendErr == nil:
+		ok, err = s.scheduledMsg.UpdateStatus(ctx, msg.ScheduledMsgID, msg.LeaseID, constant.ScheduledMsgSent, serverMsgID, "")
+	case retry && msg.Attempts < scheduledMsgMaxAttempts:
+		leaseExpire := time.Now().Add(scheduledMsgRetryInterval * time.Duration(msg.Attempts)).UnixMilli()
+		ok, err = s.scheduledMsg.Release(ctx, msg.ScheduledMsgID, msg.LeaseID, leaseExpire, sendErr.Error())
+	default:
+		ok, err = s.scheduledMsg.UpdateStatus(ctx, msg.ScheduledMsgID, msg.LeaseID, constant.ScheduledMsgFailed, "", sendErr.Error())
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrRecordNotFound.Wrap("scheduled msg lease lost")
+	}
+	return nil
+}
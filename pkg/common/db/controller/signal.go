@@ -0,0 +1,185 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/cache"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+// 通话的最长时间, 超过后房间状态会被自动清理.
+const signalRoomExpire = time.Hour * 24
+
+// 取到超时房间后的处理时限, 超过后其他实例可以重新取到.
+const signalTimeoutLease = time.Minute
+
+type SignalDatabase interface {
+	// CreateRoom 保存邀请, states k: userID, v: state, 房间已存在时返回错误
+	CreateRoom(ctx context.Context, invitation *sdkws.InvitationInfo, states map[string]int32) error
+	TakeRoom(ctx context.Context, roomID string) (*sdkws.InvitationInfo, map[string]int32, error)
+	// SetUsersState 更新参与者状态, 同时维护用户待应答的邀请和忙线状态
+	SetUsersState(ctx context.Context, roomID string, states map[string]int32) error
+	CloseRoom(ctx context.Context, roomID string, userIDs []string) error
+	GetPendingInvitations(ctx context.Context, userID string) ([]*sdkws.InvitationInfo, error)
+	// k: userID, v: roomID
+	GetBusyUsers(ctx context.Context, userIDs []string) (map[string]string, error)
+	// TakeTimeoutRoomIDs 返回已到超时时间并被当前实例取到的房间, 处理成功后需调用DoneTimeoutRoom
+	TakeTimeoutRoomIDs(ctx context.Context, count int64) ([]string, error)
+	DoneTimeoutRoom(ctx context.Context, roomID string) error
+	// LockRoom 返回的token用于UnLockRoom
+	LockRoom(ctx context.Context, roomID string) (string, error)
+	UnLockRoom(ctx context.Context, roomID string, token string) error
+}
+
+type signalDatabase struct {
+	cache cache.MsgModel
+}
+
+func NewSignalDatabase(cache cache.MsgModel) SignalDatabase {
+	return &signalDatabase{cache: cache}
+}
+
+func (s *signalDatabase) CreateRoom(ctx context.Context, invitation *sdkws.InvitationInfo, states map[string]int32) error {
+	data, err := proto.Marshal(invitation)
+	if err != nil {
+		return errs.ErrArgs.Wrap(err.Error())
+	}
+	ok, err := s.cache.CreateSignalRoom(ctx, invitation.RoomID, data, states, signalRoomExpire)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.ErrArgs.Wrap("room already exists")
+	}
+	deadline := invitation.InitiateTime + int64(invitation.Timeout)*1000
+	if err := s.cache.AddSignalInvitations(ctx, invitation.RoomID, invitation.InviteeUserIDList, deadline); err != nil {
+		return err
+	}
+	return s.cache.SetSignalBusyUsers(ctx, invitation.RoomID, []string{invitation.InviterUserID}, signalRoomExpire)
+}
+
+func (s *signalDatabase) TakeRoom(ctx context.Context, roomID string) (*sdkws.InvitationInfo, map[string]int32, error) {
+	data, states, err := s.cache.GetSignalRoom(ctx, roomID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var invitation sdkws.InvitationInfo
+	if err := proto.Unmarshal(data, &invitation); err != nil {
+		return nil, nil, errs.ErrData.Wrap(err.Error())
+	}
+	return &invitation, states, nil
+}
+
+func (s *signalDatabase) SetUsersState(ctx context.Context, roomID string, states map[string]int32) error {
+	if err := s.cache.SetSignalRoomStates(ctx, roomID, states); err != nil {
+		return err
+	}
+	var answeredUserIDs, joinedUserIDs, leftUserIDs []string
+	for userID, state := range states {
+		if state != constant.SignalStateInvited {
+			answeredUserIDs = append(answeredUserIDs, userID)
+		}
+		if state == constant.SignalStateJoined {
+			joinedUserIDs = append(joinedUserIDs, userID)
+		} else {
+			leftUserIDs = append(leftUserIDs, userID)
+		}
+	}
+	if err := s.cache.DelSignalInvitations(ctx, roomID, answeredUserIDs); err != nil {
+		return err
+	}
+	if err := s.cache.SetSignalBusyUsers(ctx, roomID, joinedUserIDs, signalRoomExpire); err != nil {
+		return err
+	}
+	return s.cache.DelSignalBusyUsers(ctx, roomID, leftUserIDs)
+}
+
+func (s *signalDatabase) CloseRoom(ctx context.Context, roomID string, userIDs []string) error {
+	if err := s.cache.DelSignalInvitations(ctx, roomID, userIDs); err != nil {
+		return err
+	}
+	if err := s.cache.DelSignalBusyUsers(ctx, roomID, userIDs); err != nil {
+		return err
+	}
+	return s.cache.DelSignalRoom(ctx, roomID)
+}
+
+func (s *signalDatabase) GetPendingInvitations(ctx context.Context, userID string) ([]*sdkws.InvitationInfo, error) {
+	roomIDs, err := s.cache.GetSignalInvitationRoomIDs(ctx, userID, utils.GetCurrentTimestampByMill())
+	if err != nil {
+		return nil, err
+	}
+	var invitations []*sdkws.InvitationInfo
+	for _, roomID := range roomIDs {
+		invitation, states, err := s.TakeRoom(ctx, roomID)
+		if err != nil {
+			if errs.Unwrap(err) == redis.Nil {
+				continue
+			}
+			return nil, err
+		}
+		if states[userID] == constant.SignalStateInvited {
+			invitations = append(invitations, invitation)
+		}
+	}
+	return invitations, nil
+}
+
+func (s *signalDatabase) GetBusyUsers(ctx context.Context, userIDs []string) (map[string]string, error) {
+	return s.cache.GetSignalBusyUsers(ctx, userIDs)
+}
+
+func (s *signalDatabase) TakeTimeoutRoomIDs(ctx context.Context, count int64) ([]string, error) {
+	now := utils.GetCurrentTimestampByMill()
+	roomIDs, err := s.cache.GetTimeoutSignalRoomIDs(ctx, now, count)
+	if err != nil {
+		return nil, err
+	}
+	lease := now + signalTimeoutLease.Milliseconds()
+	var takenRoomIDs []string
+	for _, roomID := range roomIDs {
+		ok, err := s.cache.TakeTimeoutSignalRoom(ctx, roomID, now, lease)
+		if err != nil {
+			log.ZWarn(ctx, "TakeTimeoutSignalRoom failed", err, "roomID", roomID)
+			continue
+		}
+		if ok {
+			takenRoomIDs = append(takenRoomIDs, roomID)
+		}
+	}
+	return takenRoomIDs, nil
+}
+
+func (s *signalDatabase) DoneTimeoutRoom(ctx context.Context, roomID string) error {
+	return s.cache.DelTimeoutSignalRoom(ctx, roomID)
+}
+
+func (s *signalDatabase) LockRoom(ctx context.Context, roomID string) (string, error) {
+	return s.cache.LockSignalRoom(ctx, roomID)
+}
+
+func (s *signalDatabase) UnLockRoom(ctx context.Context, roomID string, token string) error {
+	return s.cache.UnLockSignalRoom(ctx, roomID, token)
+}
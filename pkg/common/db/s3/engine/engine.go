@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/cos"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/local"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/minio"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/oss"
+)
+
+// New 根据配置文件中的 object.enable 选择对象存储实现.
+func New() (s3.Interface, error) {
+	switch enable := config.Config.Object.Enable; enable {
+	case "minio":
+		return minio.NewMinio()
+	case "cos":
+		return cos.NewCos()
+	case "oss":
+		return oss.NewOSS()
+	case "local":
+		l, err := local.NewLocal()
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	default:
+		return nil, fmt.Errorf("invalid object enable: %s", enable)
+	}
+}
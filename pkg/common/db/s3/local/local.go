@@ -0,0 +1,480 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3"
+)
+
+const (
+	minPartSize = 1024 * 1024 * 1        // 1MB
+	maxPartSize = 1024 * 1024 * 1024 * 1 // 1GB
+	maxNumSize  = 1000
+)
+
+const (
+	objectDir = "objects"
+	metaDir   = "meta"
+	uploadDir = "uploads"
+	tempDir   = "temp"
+
+	uploadInfoFile = "upload.json"
+	metaSuffix     = ".json"
+	partSuffix     = ".part"
+)
+
+var ErrInvalidName = errors.New("invalid object name")
+
+// NewLocal 本地磁盘存储, third和api服务需要访问同一目录, 签名链接由api服务处理.
+func NewLocal() (*Local, error) {
+	conf := config.Config.Object.Local
+	if conf.Dir == "" {
+		return nil, errors.New("object local dir is empty")
+	}
+	if conf.Endpoint == "" {
+		return nil, errors.New("object local endpoint is empty")
+	}
+	for _, dir := range []string{objectDir, metaDir, uploadDir, tempDir} {
+		if err := os.MkdirAll(filepath.Join(conf.Dir, dir), 0o755); err != nil {
+			return nil, err
+		}
+	}
+	endpoint := conf.Endpoint
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+	return &Local{
+		dir:      conf.Dir,
+		endpoint: endpoint,
+		secret:   []byte(config.Config.Secret),
+	}, nil
+}
+
+type Local struct {
+	dir      string
+	endpoint string
+	secret   []byte
+}
+
+type objectMeta struct {
+	ETag         string    `json:"etag"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+type uploadInfo struct {
+	Key string `json:"key"`
+}
+
+// cleanName 去掉name中的 . 和 .. 防止访问存储目录以外的文件.
+func cleanName(name string) (string, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		return "", ErrInvalidName
+	}
+	return name, nil
+}
+
+func (l *Local) objectPath(name string) string {
+	return filepath.Join(l.dir, objectDir, filepath.FromSlash(name))
+}
+
+func (l *Local) metaPath(name string) string {
+	return filepath.Join(l.dir, metaDir, filepath.FromSlash(name)+metaSuffix)
+}
+
+func (l *Local) uploadPath(uploadID string, elem ...string) (string, error) {
+	if _, err := hex.DecodeString(uploadID); err != nil || uploadID == "" {
+		return "", fmt.Errorf("invalid upload id %q", uploadID)
+	}
+	return filepath.Join(append([]string{l.dir, uploadDir, uploadID}, elem...)...), nil
+}
+
+func readJSON(name string, v any) error {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// writeFile 先写入临时文件再重命名, 读取时不会看到写了一半的文件.
+func (l *Local) writeFile(name string, r io.Reader, h hash.Hash) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return 0, err
+	}
+	tmp, err := os.CreateTemp(filepath.Join(l.dir, tempDir), "obj-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+	w := io.Writer(tmp)
+	if h != nil {
+		w = io.MultiWriter(tmp, h)
+	}
+	n, err := io.Copy(w, r)
+	if err != nil {
+		_ = tmp.Close()
+		return 0, err
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, err
+	}
+	return n, os.Rename(tmp.Name(), name)
+}
+
+func (l *Local) writeJSON(name string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = l.writeFile(name, strings.NewReader(string(data)), nil)
+	return err
+}
+
+func (l *Local) putObject(name string, r io.Reader, etag func(h hash.Hash) string) (*objectMeta, error) {
+	h := md5.New()
+	size, err := l.writeFile(l.objectPath(name), r, h)
+	if err != nil {
+		return nil, err
+	}
+	meta := &objectMeta{ETag: etag(h), Size: size, LastModified: time.Now()}
+	if err := l.writeJSON(l.metaPath(name), meta); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+func md5ETag(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (l *Local) Engine() string {
+	return "local"
+}
+
+func (l *Local) PartLimit() *s3.PartLimit {
+	return &s3.PartLimit{
+		MinPartSize: minPartSize,
+		MaxPartSize: maxPartSize,
+		MaxNumSize:  maxNumSize,
+	}
+}
+
+func (l *Local) InitiateMultipartUpload(ctx context.Context, name string) (*s3.InitiateMultipartUploadResult, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return nil, err
+	}
+	id := uuid.New()
+	uploadID := hex.EncodeToString(id[:])
+	p, err := l.uploadPath(uploadID, uploadInfoFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.writeJSON(p, &uploadInfo{Key: name}); err != nil {
+		return nil, err
+	}
+	return &s3.InitiateMultipartUploadResult{
+		Key:      name,
+		UploadID: uploadID,
+	}, nil
+}
+
+func (l *Local) getUpload(uploadID string, name string) (string, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return "", err
+	}
+	p, err := l.uploadPath(uploadID, uploadInfoFile)
+	if err != nil {
+		return "", err
+	}
+	var info uploadInfo
+	if err := readJSON(p, &info); err != nil {
+		return "", err
+	}
+	if info.Key != name {
+		return "", fmt.Errorf("upload %s key mismatch", uploadID)
+	}
+	return name, nil
+}
+
+func (l *Local) CompleteMultipartUpload(ctx context.Context, uploadID string, name string, parts []s3.Part) (*s3.CompleteMultipartUploadResult, error) {
+	name, err := l.getUpload(uploadID, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, errors.New("parts is empty")
+	}
+	files := make([]*os.File, 0, len(parts))
+	defer func() {
+		for _, file := range files {
+			_ = file.Close()
+		}
+	}()
+	readers := make([]io.Reader, 0, len(parts))
+	partMd5s := md5.New()
+	for _, part := range parts {
+		var meta objectMeta
+		p, _ := l.uploadPath(uploadID, strconv.Itoa(part.PartNumber)+metaSuffix)
+		if err := readJSON(p, &meta); err != nil {
+			return nil, fmt.Errorf("part %d not uploaded: %w", part.PartNumber, err)
+		}
+		if meta.ETag != strings.ToLower(strings.Trim(part.ETag, `"`)) {
+			return nil, fmt.Errorf("part %d etag mismatch", part.PartNumber)
+		}
+		sum, _ := hex.DecodeString(meta.ETag)
+		partMd5s.Write(sum)
+		p, _ = l.uploadPath(uploadID, strconv.Itoa(part.PartNumber)+partSuffix)
+		file, err := os.Open(p)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+		readers = append(readers, file)
+	}
+	// 与s3一致, 分片上传的etag为各分片md5拼接后的md5加分片数
+	meta, err := l.putObject(name, io.MultiReader(readers...), func(hash.Hash) string {
+		return md5ETag(partMd5s) + "-" + strconv.Itoa(len(parts))
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		_ = file.Close()
+	}
+	files = nil
+	_ = l.AbortMultipartUpload(ctx, uploadID, name)
+	return &s3.CompleteMultipartUploadResult{
+		Location: l.endpoint + name,
+		Key:      name,
+		ETag:     meta.ETag,
+	}, nil
+}
+
+func (l *Local) PartSize(ctx context.Context, size int64) (int64, error) {
+	if size <= 0 {
+		return 0, errors.New("size must be greater than 0")
+	}
+	if size > maxPartSize*maxNumSize {
+		return 0, fmt.Errorf("size must be less than %db", maxPartSize*maxNumSize)
+	}
+	if size <= minPartSize*maxNumSize {
+		return minPartSize, nil
+	}
+	partSize := size / maxNumSize
+	if size%maxNumSize != 0 {
+		partSize++
+	}
+	return partSize, nil
+}
+
+func (l *Local) AuthSign(ctx context.Context, uploadID string, name string, expire time.Duration, partNumbers []int) (*s3.AuthSignResult, error) {
+	name, err := l.getUpload(uploadID, name)
+	if err != nil {
+		return nil, err
+	}
+	result := s3.AuthSignResult{
+		URL:   l.endpoint + name,
+		Query: url.Values{"uploadId": {uploadID}},
+		Parts: make([]s3.SignPart, len(partNumbers)),
+	}
+	for i, partNumber := range partNumbers {
+		query := url.Values{
+			"uploadId":   {uploadID},
+			"partNumber": {strconv.Itoa(partNumber)},
+		}
+		result.Parts[i] = s3.SignPart{
+			PartNumber: partNumber,
+			URL:        l.signURL(httpMethodPut, name, expire, query),
+			Query:      url.Values{"partNumber": {strconv.Itoa(partNumber)}},
+		}
+	}
+	return &result, nil
+}
+
+func (l *Local) PresignedPutObject(ctx context.Context, name string, expire time.Duration) (string, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return "", err
+	}
+	return l.signURL(httpMethodPut, name, expire, url.Values{}), nil
+}
+
+func (l *Local) DeleteObject(ctx context.Context, name string) error {
+	name, err := cleanName(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(l.metaPath(name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Remove(l.objectPath(name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (l *Local) CopyObject(ctx context.Context, src string, dst string) (*s3.CopyObjectInfo, error) {
+	src, err := cleanName(src)
+	if err != nil {
+		return nil, err
+	}
+	dst, err = cleanName(dst)
+	if err != nil {
+		return nil, err
+	}
+	var srcMeta objectMeta
+	if err := readJSON(l.metaPath(src), &srcMeta); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(l.objectPath(src))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	meta, err := l.putObject(dst, file, func(h hash.Hash) string {
+		if strings.Contains(srcMeta.ETag, "-") {
+			return srcMeta.ETag
+		}
+		return md5ETag(h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s3.CopyObjectInfo{
+		Key:  dst,
+		ETag: meta.ETag,
+	}, nil
+}
+
+func (l *Local) StatObject(ctx context.Context, name string) (*s3.ObjectInfo, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return nil, err
+	}
+	var meta objectMeta
+	if err := readJSON(l.metaPath(name), &meta); err != nil {
+		return nil, err
+	}
+	return &s3.ObjectInfo{
+		ETag:         meta.ETag,
+		Key:          name,
+		Size:         meta.Size,
+		LastModified: meta.LastModified,
+	}, nil
+}
+
+func (l *Local) IsNotFound(err error) bool {
+	return errors.Is(err, os.ErrNotExist)
+}
+
+func (l *Local) AbortMultipartUpload(ctx context.Context, uploadID string, name string) error {
+	if _, err := l.getUpload(uploadID, name); err != nil {
+		return err
+	}
+	p, err := l.uploadPath(uploadID)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(p)
+}
+
+func (l *Local) ListUploadedParts(ctx context.Context, uploadID string, name string, partNumberMarker int, maxParts int) (*s3.ListUploadedPartsResult, error) {
+	name, err := l.getUpload(uploadID, name)
+	if err != nil {
+		return nil, err
+	}
+	p, _ := l.uploadPath(uploadID)
+	entries, err := os.ReadDir(p)
+	if err != nil {
+		return nil, err
+	}
+	res := &s3.ListUploadedPartsResult{
+		Key:      name,
+		UploadID: uploadID,
+		MaxParts: maxParts,
+	}
+	for _, entry := range entries {
+		partNumber, err := strconv.Atoi(strings.TrimSuffix(entry.Name(), metaSuffix))
+		if err != nil || partNumber <= partNumberMarker {
+			continue
+		}
+		var meta objectMeta
+		if err := readJSON(filepath.Join(p, entry.Name()), &meta); err != nil {
+			return nil, err
+		}
+		res.UploadedParts = append(res.UploadedParts, s3.UploadedPart{
+			PartNumber:   partNumber,
+			LastModified: meta.LastModified,
+			ETag:         meta.ETag,
+			Size:         meta.Size,
+		})
+	}
+	sort.Slice(res.UploadedParts, func(i, j int) bool {
+		return res.UploadedParts[i].PartNumber < res.UploadedParts[j].PartNumber
+	})
+	if maxParts > 0 && len(res.UploadedParts) > maxParts {
+		res.UploadedParts = res.UploadedParts[:maxParts]
+	}
+	if n := len(res.UploadedParts); n > 0 {
+		res.NextPartNumberMarker = res.UploadedParts[n-1].PartNumber
+	}
+	return res, nil
+}
+
+func (l *Local) AccessURL(ctx context.Context, name string, expire time.Duration, opt *s3.AccessURLOption) (string, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return "", err
+	}
+	if expire <= 0 {
+		expire = time.Hour * 24 * 365 * 99 // 99 years
+	} else if expire < time.Second {
+		expire = time.Second
+	}
+	query := url.Values{}
+	if opt != nil {
+		if opt.ContentType != "" {
+			query.Set(queryContentType, opt.ContentType)
+		}
+		if opt.Filename != "" {
+			query.Set(queryFilename, opt.Filename)
+		}
+	}
+	return l.signURL(httpMethodGet, name, expire, query), nil
+}
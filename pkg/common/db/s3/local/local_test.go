@@ -0,0 +1,181 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3/cont"
+)
+
+func md5Hex(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func putURL(t *testing.T, rawURL string, data []byte) string {
+	req, err := http.NewRequest(http.MethodPut, rawURL, bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		t.Fatal("put failed", resp.StatusCode, string(body))
+	}
+	return resp.Header.Get("ETag")
+}
+
+func TestLocalUpload(t *testing.T) {
+	var l *Local
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, err := l.Verify(r.Method, strings.TrimPrefix(r.URL.Path, "/local_object/"), r.URL.Query())
+		if err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			var etag string
+			if uploadID := r.URL.Query().Get(queryUploadID); uploadID != "" {
+				partNumber, _ := strconv.Atoi(r.URL.Query().Get(queryPartNumber))
+				part, err := l.UploadPart(r.Context(), uploadID, name, partNumber, r.Body)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				etag = part.ETag
+			} else {
+				info, err := l.PutObject(r.Context(), name, r.Body)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				etag = info.ETag
+			}
+			w.Header().Set("ETag", strconv.Quote(etag))
+		case http.MethodGet:
+			file, info, err := l.Open(r.Context(), name)
+			if err != nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			defer file.Close()
+			http.ServeContent(w, r, info.Key, info.LastModified, file)
+		}
+	}))
+	defer srv.Close()
+	config.Config.Secret = "test"
+	config.Config.Object.Local.Dir = t.TempDir()
+	config.Config.Object.Local.Endpoint = srv.URL + "/local_object/"
+	var err error
+	l, err = NewLocal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	c := cont.New(l)
+
+	// 预签名上传
+	small := []byte("hello local object")
+	hash := md5Hex([]byte(md5Hex(small)))
+	upload, err := c.InitiateUpload(ctx, hash, int64(len(small)), time.Hour, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	putURL(t, upload.Sign.Parts[0].URL, small)
+	res, err := c.CompleteUpload(ctx, upload.UploadID, []string{md5Hex(small)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Key != c.HashPath(hash) || res.Size != int64(len(small)) {
+		t.Fatal("unexpected result", res)
+	}
+	if _, err := c.InitiateUpload(ctx, hash, int64(len(small)), time.Hour, -1); err == nil {
+		t.Fatal("hash object should exist")
+	}
+
+	// 分片上传
+	large := bytes.Repeat([]byte("0123456789abcdef"), minPartSize/16*2+100)
+	partSize, err := c.PartSize(ctx, int64(len(large)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parts [][]byte
+	var partHashs []string
+	for i := int64(0); i < int64(len(large)); i += partSize {
+		end := i + partSize
+		if end > int64(len(large)) {
+			end = int64(len(large))
+		}
+		parts = append(parts, large[i:end])
+		partHashs = append(partHashs, md5Hex(large[i:end]))
+	}
+	hash = md5Hex([]byte(strings.Join(partHashs, ",")))
+	upload, err = c.InitiateUpload(ctx, hash, int64(len(large)), time.Hour, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(upload.Sign.Parts) != 3 {
+		t.Fatal("unexpected part num", len(upload.Sign.Parts))
+	}
+	for i, part := range upload.Sign.Parts {
+		if etag := putURL(t, part.URL, parts[i]); etag != strconv.Quote(partHashs[i]) {
+			t.Fatal("unexpected part etag", etag)
+		}
+	}
+	res, err = c.CompleteUpload(ctx, upload.UploadID, partHashs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 签名下载
+	u, err := c.AccessURL(ctx, res.Key, time.Minute, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !bytes.Equal(data, large) {
+		t.Fatal("downloaded content mismatch", len(data))
+	}
+	resp, err = http.Get(strings.Replace(u, "sign=", "sign=0", 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatal("tampered sign accepted", resp.StatusCode)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/s3"
+)
+
+const (
+	httpMethodGet = http.MethodGet
+	httpMethodPut = http.MethodPut
+
+	queryExpires     = "expires"
+	querySign        = "sign"
+	queryUploadID    = "uploadId"
+	queryPartNumber  = "partNumber"
+	queryContentType = "response-content-type"
+	queryFilename    = "filename"
+)
+
+var (
+	ErrSignInvalid = errors.New("invalid sign")
+	ErrSignExpired = errors.New("sign expired")
+)
+
+// sign 签名内容为请求方法、对象名和所有参与签名的参数.
+func (l *Local) sign(method string, name string, query url.Values) string {
+	mac := hmac.New(sha256.New, l.secret)
+	_, _ = io.WriteString(mac, strings.Join([]string{
+		method,
+		name,
+		query.Get(queryExpires),
+		query.Get(queryUploadID),
+		query.Get(queryPartNumber),
+		query.Get(queryContentType),
+		query.Get(queryFilename),
+	}, "\n"))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (l *Local) signURL(method string, name string, expire time.Duration, query url.Values) string {
+	query.Set(queryExpires, strconv.FormatInt(time.Now().Add(expire).Unix(), 10))
+	query.Set(querySign, l.sign(method, name, query))
+	return l.endpoint + (&url.URL{Path: name}).EscapedPath() + "?" + query.Encode()
+}
+
+// Verify 校验api收到的签名链接.
+func (l *Local) Verify(method string, name string, query url.Values) (string, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return "", err
+	}
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	if !hmac.Equal([]byte(query.Get(querySign)), []byte(l.sign(method, name, query))) {
+		return "", ErrSignInvalid
+	}
+	expires, err := strconv.ParseInt(query.Get(queryExpires), 10, 64)
+	if err != nil {
+		return "", ErrSignInvalid
+	}
+	if time.Now().Unix() > expires {
+		return "", ErrSignExpired
+	}
+	return name, nil
+}
+
+// PutObject 处理预签名上传, 超过maxPartSize时返回错误.
+func (l *Local) PutObject(ctx context.Context, name string, r io.Reader) (*s3.ObjectInfo, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return nil, err
+	}
+	lr := &io.LimitedReader{R: r, N: maxPartSize + 1}
+	meta, err := l.putObject(name, lr, md5ETag)
+	if err != nil {
+		return nil, err
+	}
+	if lr.N <= 0 {
+		_ = l.DeleteObject(ctx, name)
+		return nil, errors.New("object too large")
+	}
+	return &s3.ObjectInfo{
+		ETag:         meta.ETag,
+		Key:          name,
+		Size:         meta.Size,
+		LastModified: meta.LastModified,
+	}, nil
+}
+
+// UploadPart 处理AuthSign签名的分片上传.
+func (l *Local) UploadPart(ctx context.Context, uploadID string, name string, partNumber int, r io.Reader) (*s3.UploadedPart, error) {
+	if _, err := l.getUpload(uploadID, name); err != nil {
+		return nil, err
+	}
+	if partNumber <= 0 || partNumber > maxNumSize {
+		return nil, errors.New("invalid part number")
+	}
+	partPath, err := l.uploadPath(uploadID, strconv.Itoa(partNumber)+partSuffix)
+	if err != nil {
+		return nil, err
+	}
+	h := md5.New()
+	lr := &io.LimitedReader{R: r, N: maxPartSize + 1}
+	size, err := l.writeFile(partPath, lr, h)
+	if err != nil {
+		return nil, err
+	}
+	if lr.N <= 0 {
+		_ = os.Remove(partPath)
+		return nil, errors.New("part too large")
+	}
+	meta := &objectMeta{ETag: md5ETag(h), Size: size, LastModified: time.Now()}
+	metaPath, _ := l.uploadPath(uploadID, strconv.Itoa(partNumber)+metaSuffix)
+	if err := l.writeJSON(metaPath, meta); err != nil {
+		return nil, err
+	}
+	return &s3.UploadedPart{
+		PartNumber:   partNumber,
+		LastModified: meta.LastModified,
+		ETag:         meta.ETag,
+		Size:         meta.Size,
+	}, nil
+}
+
+// Open 打开对象文件用于下载, 调用方负责关闭.
+func (l *Local) Open(ctx context.Context, name string) (*os.File, *s3.ObjectInfo, error) {
+	info, err := l.StatObject(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := os.Open(l.objectPath(info.Key))
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, info, nil
+}
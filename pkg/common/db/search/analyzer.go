@@ -0,0 +1,125 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
+// Analyze splits text into lower case terms for indexing, letters and digits form a term,
+// CJK text has no separator so every rune and every bigram of it is a term.
+func Analyze(text string) []string {
+	return analyze(text, true)
+}
+
+// AnalyzeQuery splits a keyword like Analyze, CJK runs longer than one rune only produce bigrams
+// since the bigrams of a keyword already imply its runes.
+func AnalyzeQuery(keyword string) []string {
+	return analyze(keyword, false)
+}
+
+func analyze(text string, unigram bool) []string {
+	var (
+		terms []string
+		word  []rune
+		cjk   []rune
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			terms = append(terms, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		if unigram || len(cjk) == 1 {
+			for i := range cjk {
+				terms = append(terms, string(cjk[i]))
+			}
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			terms = append(terms, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return terms
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// ExtractText returns the searchable text of msg content, all string values of json content are joined except urls.
+func ExtractText(content []byte) string {
+	var v any
+	if err := json.Unmarshal(content, &v); err != nil {
+		return string(content)
+	}
+	switch v.(type) {
+	case map[string]any, []any:
+	default:
+		return string(content)
+	}
+	var texts []string
+	var walk func(v any)
+	walk = func(v any) {
+		switch val := v.(type) {
+		case string:
+			if !strings.HasPrefix(val, "http://") && !strings.HasPrefix(val, "https://") {
+				texts = append(texts, val)
+			}
+		case []any:
+			for _, e := range val {
+				walk(e)
+			}
+		case map[string]any:
+			for _, e := range val {
+				walk(e)
+			}
+		}
+	}
+	walk(v)
+	return strings.Join(texts, " ")
+}
+
+// Match reports whether content contains all terms of keyword.
+func Match(keyword string, content []byte) bool {
+	terms := make(map[string]struct{})
+	for _, term := range Analyze(ExtractText(content)) {
+		terms[term] = struct{}{}
+	}
+	for _, term := range AnalyzeQuery(keyword) {
+		if _, ok := terms[term]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,414 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const (
+	localLogName  = "msg_index.log"
+	localLockName = "msg_index.lock"
+)
+
+// 超过maxSize压缩时保留的比例, 避免每次写入都触发压缩
+const localCompactRatio = 0.8
+
+// BM25 parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+type localKey struct {
+	ConversationID string
+	Seq            int64
+}
+
+type localDoc struct {
+	ConversationID string           `json:"cid"`
+	Seq            int64            `json:"seq"`
+	SendID         string           `json:"sid"`
+	RecvID         string           `json:"rid"`
+	SessionType    int32            `json:"st"`
+	ContentType    int32            `json:"ct"`
+	SendTime       int64            `json:"t"`
+	Terms          map[string]int32 `json:"terms"`
+	Length         int32            `json:"len"`
+}
+
+// localIndex is an embedded index stored as an append only log of analyzed msgs in dir.
+// Only one process may index into dir, OpenWriter holds an exclusive lock on dir to enforce it.
+// Processes searching the same dir load the log into an in memory inverted index and pick up
+// appended records on every search. When the log grows over maxSize the writer compacts it,
+// dropping duplicated records and the oldest msgs, and readers reload the replaced log.
+type localIndex struct {
+	dir     string
+	path    string
+	maxSize int64
+
+	writeLock sync.Mutex
+	writer    *os.File
+	lockFile  *os.File
+
+	lock        sync.RWMutex
+	file        os.FileInfo
+	offset      int64
+	docs        map[localKey]*localDoc
+	postings    map[string]map[localKey]int32
+	totalLength int64
+}
+
+// NewLocalIndex maxSize is the size bound of the log in bytes, 0 means no limit.
+func NewLocalIndex(dir string, maxSize int64) (MessageSearchIndex, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return &localIndex{
+		dir:      dir,
+		path:     filepath.Join(dir, localLogName),
+		maxSize:  maxSize,
+		docs:     make(map[localKey]*localDoc),
+		postings: make(map[string]map[localKey]int32),
+	}, nil
+}
+
+func (l *localIndex) Index(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, msg := range msgs {
+		if msg == nil || msg.ContentType >= constant.NotificationBegin {
+			continue
+		}
+		terms := Analyze(ExtractText(msg.Content))
+		if len(terms) == 0 {
+			continue
+		}
+		doc := &localDoc{
+			ConversationID: conversationID,
+			Seq:            msg.Seq,
+			SendID:         msg.SendID,
+			RecvID:         msg.RecvID,
+			SessionType:    msg.SessionType,
+			ContentType:    msg.ContentType,
+			SendTime:       msg.SendTime,
+			Terms:          make(map[string]int32),
+			Length:         int32(len(terms)),
+		}
+		if msg.SessionType == constant.SuperGroupChatType {
+			doc.RecvID = msg.GroupID
+		}
+		for _, term := range terms {
+			doc.Terms[term]++
+		}
+		if err := enc.Encode(doc); err != nil {
+			return utils.Wrap(err, "")
+		}
+	}
+	if buf.Len() == 0 {
+		return nil
+	}
+	l.writeLock.Lock()
+	defer l.writeLock.Unlock()
+	if l.writer == nil {
+		return errs.ErrInternalServer.Wrap("msg index writer is not opened")
+	}
+	// 一次写入整批记录, 读取方只会处理到最后一个完整的行
+	if _, err := l.writer.Write(buf.Bytes()); err != nil {
+		return utils.Wrap(err, "")
+	}
+	if l.maxSize > 0 {
+		info, err := l.writer.Stat()
+		if err != nil {
+			return utils.Wrap(err, "")
+		}
+		if info.Size() > l.maxSize {
+			if err := l.compact(ctx); err != nil {
+				log.ZError(ctx, "compact msg index failed", err, "path", l.path, "size", info.Size())
+			}
+		}
+	}
+	return nil
+}
+
+func (l *localIndex) OpenWriter() error {
+	l.writeLock.Lock()
+	defer l.writeLock.Unlock()
+	if l.writer != nil {
+		return nil
+	}
+	lockFile, err := os.OpenFile(filepath.Join(l.dir, localLockName), os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	if err := lockFileExclusive(lockFile); err != nil {
+		_ = lockFile.Close()
+		return errs.Wrap(fmt.Errorf("msg index %s is locked by another writer: %w", l.dir, err))
+	}
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		_ = lockFile.Close()
+		return utils.Wrap(err, "")
+	}
+	l.lockFile = lockFile
+	l.writer = f
+	return nil
+}
+
+// compact rewrites the log with the latest record of every msg, newest msgs first, until
+// maxSize*localCompactRatio, then replaces the log so readers reload it. Must hold writeLock.
+func (l *localIndex) compact(ctx context.Context) error {
+	if err := l.refresh(ctx); err != nil {
+		return err
+	}
+	l.lock.RLock()
+	docs := make([]*localDoc, 0, len(l.docs))
+	for _, doc := range l.docs {
+		docs = append(docs, doc)
+	}
+	l.lock.RUnlock()
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i].SendTime > docs[j].SendTime
+	})
+	tmp, err := os.CreateTemp(l.dir, localLogName+".*")
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	defer os.Remove(tmp.Name())
+	w := bufio.NewWriter(tmp)
+	limit := int64(float64(l.maxSize) * localCompactRatio)
+	var size int64
+	var kept int
+	for _, doc := range docs {
+		data, err := json.Marshal(doc)
+		if err != nil {
+			_ = tmp.Close()
+			return utils.Wrap(err, "")
+		}
+		if size+int64(len(data))+1 > limit {
+			break
+		}
+		size += int64(len(data)) + 1
+		kept++
+		_, _ = w.Write(data)
+		_ = w.WriteByte('\n')
+	}
+	if err := w.Flush(); err != nil {
+		_ = tmp.Close()
+		return utils.Wrap(err, "")
+	}
+	if err := tmp.Close(); err != nil {
+		return utils.Wrap(err, "")
+	}
+	if err := os.Rename(tmp.Name(), l.path); err != nil {
+		return utils.Wrap(err, "")
+	}
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	_ = l.writer.Close()
+	l.writer = f
+	log.ZInfo(ctx, "msg index compacted", "path", l.path, "docs", len(docs), "kept", kept, "size", size)
+	return nil
+}
+
+func (l *localIndex) Search(ctx context.Context, req *SearchReq) (int, []*Hit, error) {
+	terms := utils.Distinct(AnalyzeQuery(req.Keyword))
+	if len(terms) == 0 {
+		return 0, nil, errs.ErrArgs.Wrap("keyword has no searchable term")
+	}
+	if err := l.refresh(ctx); err != nil {
+		return 0, nil, err
+	}
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	// 从文档数最少的词开始求交集
+	sort.Slice(terms, func(i, j int) bool {
+		return len(l.postings[terms[i]]) < len(l.postings[terms[j]])
+	})
+	conversationIDs := make(map[string]struct{}, len(req.ConversationIDs))
+	for _, conversationID := range req.ConversationIDs {
+		conversationIDs[conversationID] = struct{}{}
+	}
+	avgLength := float64(l.totalLength) / math.Max(float64(len(l.docs)), 1)
+	var hits []*Hit
+	for key := range l.postings[terms[0]] {
+		doc := l.docs[key]
+		if !l.filter(doc, req, conversationIDs) {
+			continue
+		}
+		var score float64
+		matched := true
+		for _, term := range terms {
+			tf, ok := l.postings[term][key]
+			if !ok {
+				matched = false
+				break
+			}
+			df := float64(len(l.postings[term]))
+			idf := math.Log(1 + (float64(len(l.docs))-df+0.5)/(df+0.5))
+			norm := bm25K1 * (1 - bm25B + bm25B*float64(doc.Length)/avgLength)
+			score += idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + norm)
+		}
+		if matched {
+			hits = append(hits, &Hit{ConversationID: doc.ConversationID, Seq: doc.Seq, SendTime: doc.SendTime, Score: score})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score == hits[j].Score {
+			return hits[i].SendTime > hits[j].SendTime
+		}
+		return hits[i].Score > hits[j].Score
+	})
+	total := len(hits)
+	if req.Offset >= total {
+		return total, nil, nil
+	}
+	hits = hits[req.Offset:]
+	if req.Limit > 0 && len(hits) > req.Limit {
+		hits = hits[:req.Limit]
+	}
+	return total, hits, nil
+}
+
+func (l *localIndex) filter(doc *localDoc, req *SearchReq, conversationIDs map[string]struct{}) bool {
+	if len(conversationIDs) > 0 {
+		if _, ok := conversationIDs[doc.ConversationID]; !ok {
+			return false
+		}
+	}
+	if req.SendID != "" && doc.SendID != req.SendID {
+		return false
+	}
+	if req.RecvID != "" && doc.RecvID != req.RecvID {
+		return false
+	}
+	if req.SessionType != 0 && doc.SessionType != req.SessionType {
+		return false
+	}
+	if req.ContentType != 0 && doc.ContentType != req.ContentType {
+		return false
+	}
+	if req.StartTime != 0 && doc.SendTime < req.StartTime {
+		return false
+	}
+	if req.EndTime != 0 && doc.SendTime >= req.EndTime {
+		return false
+	}
+	return true
+}
+
+// refresh loads records appended to the log since the last refresh.
+func (l *localIndex) refresh(ctx context.Context) error {
+	f, err := os.Open(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.file != nil && !os.SameFile(l.file, info) || info.Size() < l.offset {
+		// 日志被压缩替换或截断, 重新加载
+		l.offset = 0
+		l.docs = make(map[localKey]*localDoc)
+		l.postings = make(map[string]map[localKey]int32)
+		l.totalLength = 0
+	}
+	l.file = info
+	if info.Size() == l.offset {
+		return nil
+	}
+	data := make([]byte, info.Size()-l.offset)
+	if _, err := f.ReadAt(data, l.offset); err != nil && err != io.EOF {
+		return utils.Wrap(err, "")
+	}
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		return nil
+	}
+	for _, line := range bytes.Split(data[:end], []byte{'\n'}) {
+		var doc localDoc
+		if err := json.Unmarshal(line, &doc); err != nil {
+			log.ZWarn(ctx, "skip broken msg index record", err, "path", l.path, "offset", l.offset)
+			continue
+		}
+		l.add(&doc)
+	}
+	l.offset += int64(end + 1)
+	return nil
+}
+
+func (l *localIndex) add(doc *localDoc) {
+	key := localKey{ConversationID: doc.ConversationID, Seq: doc.Seq}
+	if old, ok := l.docs[key]; ok {
+		// 同一条消息重复投递时以最后一次为准
+		for term := range old.Terms {
+			delete(l.postings[term], key)
+			if len(l.postings[term]) == 0 {
+				delete(l.postings, term)
+			}
+		}
+		l.totalLength -= int64(old.Length)
+	}
+	l.docs[key] = doc
+	l.totalLength += int64(doc.Length)
+	for term, tf := range doc.Terms {
+		posting, ok := l.postings[term]
+		if !ok {
+			posting = make(map[localKey]int32)
+			l.postings[term] = posting
+		}
+		posting[key] = tf
+	}
+}
+
+func (l *localIndex) Close() error {
+	l.writeLock.Lock()
+	defer l.writeLock.Unlock()
+	if l.writer == nil {
+		return nil
+	}
+	err := l.writer.Close()
+	l.writer = nil
+	// 关闭文件即释放锁
+	_ = l.lockFile.Close()
+	l.lockFile = nil
+	return utils.Wrap(err, "")
+}
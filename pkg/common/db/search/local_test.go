@@ -0,0 +1,147 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+func TestLocalIndex(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	writer, err := NewLocalIndex(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer writer.Close()
+	if err := writer.OpenWriter(); err != nil {
+		t.Fatal(err)
+	}
+	msgs := []*sdkws.MsgData{
+		{Seq: 1, SendID: "a", ContentType: constant.Text, SendTime: 1, Content: []byte(`{"content":"hello open im"}`)},
+		{Seq: 2, SendID: "b", ContentType: constant.Text, SendTime: 2, Content: []byte(`{"content":"今天天气不错, hello"}`)},
+		{Seq: 3, SendID: "a", ContentType: constant.MsgRevokeNotification, SendTime: 3, Content: []byte(`{"content":"hello"}`)},
+	}
+	if err := writer.Index(ctx, "si_a_b", msgs); err != nil {
+		t.Fatal(err)
+	}
+	// 读取方为另一个实例, 模拟msg rpc读取msgtransfer写入的索引
+	reader, err := NewLocalIndex(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	total, hits, err := reader.Search(ctx, &SearchReq{Keyword: "Hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 {
+		t.Fatalf("expect 2 hits, got %d", total)
+	}
+	_, hits, err = reader.Search(ctx, &SearchReq{Keyword: "天气"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Seq != 2 {
+		t.Fatalf("unexpected hits %+v", hits)
+	}
+	// 单字关键词匹配更长的中文
+	_, hits, err = reader.Search(ctx, &SearchReq{Keyword: "气"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Seq != 2 {
+		t.Fatalf("unexpected hits %+v", hits)
+	}
+	_, hits, err = reader.Search(ctx, &SearchReq{Keyword: "hello", SendID: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].Seq != 1 {
+		t.Fatalf("unexpected hits %+v", hits)
+	}
+	if err := writer.Index(ctx, "si_a_b", []*sdkws.MsgData{{Seq: 4, ContentType: constant.Text, Content: []byte(`{"content":"hello again"}`)}}); err != nil {
+		t.Fatal(err)
+	}
+	total, _, err = reader.Search(ctx, &SearchReq{Keyword: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 3 {
+		t.Fatalf("expect 3 hits after append, got %d", total)
+	}
+}
+
+func TestLocalIndexCompact(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	const maxSize = 4096
+	writer, err := NewLocalIndex(dir, maxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer writer.Close()
+	if err := writer.Index(ctx, "si_a_b", []*sdkws.MsgData{{Seq: 1, ContentType: constant.Text, Content: []byte(`{"content":"hello"}`)}}); err == nil {
+		t.Fatal("expect error before OpenWriter")
+	}
+	if err := writer.OpenWriter(); err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewLocalIndex(dir, maxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.OpenWriter(); err == nil {
+		t.Fatal("expect second writer to be rejected")
+	}
+	reader, err := NewLocalIndex(dir, maxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := int64(1); i <= 200; i++ {
+		msg := &sdkws.MsgData{Seq: i, SendID: "a", ContentType: constant.Text, SendTime: i, Content: []byte(`{"content":"hello open im"}`)}
+		if err := writer.Index(ctx, "si_a_b", []*sdkws.MsgData{msg}); err != nil {
+			t.Fatal(err)
+		}
+		if i == 1 {
+			// 读取方在压缩前已加载
+			if _, _, err := reader.Search(ctx, &SearchReq{Keyword: "hello"}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	info, err := os.Stat(filepath.Join(dir, localLogName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() > maxSize {
+		t.Fatalf("index size %d over %d", info.Size(), maxSize)
+	}
+	total, hits, err := reader.Search(ctx, &SearchReq{Keyword: "hello", Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total == 0 || total >= 200 {
+		t.Fatalf("unexpected total %d", total)
+	}
+	if len(hits) != 1 || hits[0].Seq != 200 {
+		t.Fatalf("unexpected hits %+v", hits)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+// MessageSearchIndex is a full text index of msgs. Hits only tell where a msg matched when it was indexed,
+// callers must check whether the msg is still visible, deleted and revoked msgs are not removed from the index.
+type MessageSearchIndex interface {
+	Index(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+	Search(ctx context.Context, req *SearchReq) (total int, hits []*Hit, err error)
+	// OpenWriter must be called by the only indexing process before Index.
+	OpenWriter() error
+	Close() error
+}
+
+type SearchReq struct {
+	Keyword string
+	// ConversationIDs limits the search to these conversations, empty means all conversations.
+	ConversationIDs []string
+	SendID          string
+	RecvID          string
+	SessionType     int32
+	ContentType     int32
+	// StartTime and EndTime are send time in milliseconds, 0 means no limit.
+	StartTime int64
+	EndTime   int64
+	Offset    int
+	Limit     int
+}
+
+type Hit struct {
+	ConversationID string
+	Seq            int64
+	SendTime       int64
+	Score          float64
+}
+
+// NewMessageSearchIndex returns nil if msg search is not enabled.
+func NewMessageSearchIndex() (MessageSearchIndex, error) {
+	enable := config.Config.MsgSearch.Enable
+	switch enable {
+	case "":
+		return nil, nil
+	case "local":
+		return NewLocalIndex(config.Config.MsgSearch.Local.Dir, int64(config.Config.MsgSearch.Local.MaxSize)*1024*1024)
+	default:
+		return nil, fmt.Errorf("invalid msg search enable: %s", enable)
+	}
+}
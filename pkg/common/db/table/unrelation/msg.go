@@ -36,6 +36,20 @@ const (
 type MsgDocModel struct {
 	DocID string          `bson:"doc_id"`
 	Msg   []*MsgInfoModel `bson:"msgs"`
+	// Version 每次修改msgs时加一, 归档时只在文档未被修改时清空msgs
+	Version int64               `bson:"version"`
+	Archive *MsgDocArchiveModel `bson:"archive,omitempty"`
+}
+
+// MsgDocArchiveModel 文档归档到对象存储后留在mongo中的信息, 归档后msgs为空, 消息只读.
+type MsgDocArchiveModel struct {
+	Key          string `bson:"key"`
+	MsgNum       int64  `bson:"msg_num"`
+	MinSeq       int64  `bson:"min_seq"`
+	MaxSeq       int64  `bson:"max_seq"`
+	LastSendTime int64  `bson:"last_send_time"`
+	Size         int64  `bson:"size"`
+	ArchiveTime  int64  `bson:"archive_time"`
 }
 
 type RevokeModel struct {
@@ -45,6 +59,22 @@ type RevokeModel struct {
 	Time     int64  `bson:"time"`
 }
 
+// EditModel keeps the content a message had before one edit.
+type EditModel struct {
+	Content  string `bson:"content"`
+	UserID   string `bson:"user_id"`
+	Nickname string `bson:"nickname"`
+	Time     int64  `bson:"time"`
+}
+
+// ReactionModel is one reaction extension of a message, keyed by TypeKey.
+type ReactionModel struct {
+	TypeKey          string `bson:"type_key"`
+	Value            string `bson:"value"`
+	UserID           string `bson:"user_id"`
+	LatestUpdateTime int64  `bson:"latest_update_time"`
+}
+
 type OfflinePushModel struct {
 	Title         string `bson:"title"`
 	Desc          string `bson:"desc"`
@@ -76,13 +106,16 @@ type MsgDataModel struct {
 	AtUserIDList     []string          `bson:"at_user_id_list"`
 	AttachedInfo     string            `bson:"attached_info"`
 	Ex               string            `bson:"ex"`
+	ThreadRootSeq    int64             `bson:"thread_root_seq"`
 }
 
 type MsgInfoModel struct {
-	Msg     *MsgDataModel `bson:"msg"`
-	Revoke  *RevokeModel  `bson:"revoke"`
-	DelList []string      `bson:"del_list"`
-	IsRead  bool          `bson:"is_read"`
+	Msg         *MsgDataModel             `bson:"msg"`
+	Revoke      *RevokeModel              `bson:"revoke"`
+	EditHistory []*EditModel              `bson:"edit_history"`
+	Reactions   map[string]*ReactionModel `bson:"reactions"`
+	DelList     []string                  `bson:"del_list"`
+	IsRead      bool                      `bson:"is_read"`
 }
 
 type UserCount struct {
@@ -100,10 +133,19 @@ type MsgDocModelInterface interface {
 	Create(ctx context.Context, model *MsgDocModel) error
 	UpdateMsg(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
 	PushUnique(ctx context.Context, docID string, index int64, key string, value any) (*mongo.UpdateResult, error)
+	UnsetMsg(ctx context.Context, docID string, index int64, key string) (*mongo.UpdateResult, error)
 	UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error
+	EditMsgContent(ctx context.Context, docID string, index int64, content string, edit *EditModel) (*mongo.UpdateResult, error)
 	IsExistDocID(ctx context.Context, docID string) (bool, error)
 	FindOneByDocID(ctx context.Context, docID string) (*MsgDocModel, error)
 	GetMsgBySeqIndexIn1Doc(ctx context.Context, userID, docID string, seqs []int64) ([]*MsgInfoModel, error)
+	// FindArchivableDocIDs 返回已写满、最后一条消息发送时间早于before且未归档的文档
+	FindArchivableDocIDs(ctx context.Context, before int64, limit int64) ([]string, error)
+	// ArchiveDoc 清空文档中的消息并保存归档信息, 文档已归档时不做修改
+	// ArchiveDoc 文档的version仍为version时写入归档信息并清空msgs, 文档已被修改时返回false
+	ArchiveDoc(ctx context.Context, docID string, version int64, archive *MsgDocArchiveModel) (bool, error)
+	// GetDocArchive 文档未归档时返回nil
+	GetDocArchive(ctx context.Context, docID string) (*MsgDocArchiveModel, error)
 	GetNewestMsg(ctx context.Context, conversationID string) (*MsgInfoModel, error)
 	GetOldestMsg(ctx context.Context, conversationID string) (*MsgInfoModel, error)
 	DeleteDocs(ctx context.Context, docIDs []string) error
@@ -139,6 +181,9 @@ func (MsgDocModel) GetSingleGocMsgNum() int64 {
 }
 
 func (m *MsgDocModel) IsFull() bool {
+	if m.Archive != nil {
+		return true
+	}
 	return m.Msg[len(m.Msg)-1].Msg != nil
 }
 
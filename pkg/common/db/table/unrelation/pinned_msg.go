@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unrelation
+
+import (
+	"context"
+)
+
+const (
+	CPinnedMsg      = "pinned_msg"
+	CPinnedMsgCount = "pinned_msg_count"
+)
+
+type PinnedMsgModel struct {
+	ConversationID string `bson:"conversation_id"`
+	Seq            int64  `bson:"seq"`
+	ClientMsgID    string `bson:"client_msg_id"`
+	PinUserID      string `bson:"pin_user_id"`
+	PinTime        int64  `bson:"pin_time"`
+}
+
+func (PinnedMsgModel) TableName() string {
+	return CPinnedMsg
+}
+
+type PinnedMsgModelInterface interface {
+	Create(ctx context.Context, pinnedMsg *PinnedMsgModel) error
+	// Delete returns false if the msg is not pinned.
+	Delete(ctx context.Context, conversationID string, seq int64) (bool, error)
+	Count(ctx context.Context, conversationID string) (int64, error)
+	// IncrCount atomically increases the pinned count of a conversation, returns false if it reaches maxNum.
+	// The count is recomputed from the pinned msgs before refusing, in case pins were removed without DecrCount.
+	IncrCount(ctx context.Context, conversationID string, maxNum int64) (bool, error)
+	DecrCount(ctx context.Context, conversationID string) error
+	// Find returns pinned msgs of a conversation, the latest pinned first.
+	Find(ctx context.Context, conversationID string) ([]*PinnedMsgModel, error)
+}
@@ -0,0 +1,50 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unrelation
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	CRetentionPolicy = "retention_policy"
+)
+
+// RetentionPolicyModel 群或用户的消息保留策略, 用户策略只作用于单聊会话.
+type RetentionPolicyModel struct {
+	TargetType int32  `bson:"target_type"`
+	TargetID   string `bson:"target_id"`
+	// 消息保留时间(秒), 0表示使用全局配置
+	MaxAge int64 `bson:"max_age"`
+	// 会话保留的最大消息数, 0表示不限制
+	MaxMsgNum  int64     `bson:"max_msg_num"`
+	LegalHold  bool      `bson:"legal_hold"`
+	OpUserID   string    `bson:"op_user_id"`
+	CreateTime time.Time `bson:"create_time"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+func (RetentionPolicyModel) TableName() string {
+	return CRetentionPolicy
+}
+
+type RetentionPolicyModelInterface interface {
+	Upsert(ctx context.Context, policy *RetentionPolicyModel) error
+	// Delete returns false if the policy does not exist.
+	Delete(ctx context.Context, targetType int32, targetID string) (bool, error)
+	// Find returns policies of targetType, all policies when targetType is 0.
+	Find(ctx context.Context, targetType int32, pageNumber, showNumber int32) (int64, []*RetentionPolicyModel, error)
+}
@@ -0,0 +1,63 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unrelation
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	CScheduledMsg = "scheduled_msg"
+)
+
+type ScheduledMsgModel struct {
+	ScheduledMsgID string `bson:"scheduled_msg_id"`
+	SendID         string `bson:"send_id"`
+	SendTime       int64  `bson:"send_time"`
+	Status         int32  `bson:"status"`
+	// MsgData is the proto encoded sdkws.MsgData passed to SendMsg when due.
+	MsgData     []byte `bson:"msg_data"`
+	ServerMsgID string `bson:"server_msg_id"`
+	ErrMsg      string `bson:"err_msg"`
+	// LeaseExpire is when a sending msg can be taken again, in milliseconds.
+	LeaseExpire int64 `bson:"lease_expire"`
+	// LeaseID identifies the dispatcher currently sending the msg, only it can write back the result.
+	LeaseID string `bson:"lease_id"`
+	// Attempts is how many times the msg has been taken for sending.
+	Attempts   int32     `bson:"attempts"`
+	CreateTime time.Time `bson:"create_time"`
+	UpdateTime time.Time `bson:"update_time"`
+}
+
+func (ScheduledMsgModel) TableName() string {
+	return CScheduledMsg
+}
+
+type ScheduledMsgModelInterface interface {
+	Create(ctx context.Context, msg *ScheduledMsgModel) error
+	Take(ctx context.Context, scheduledMsgID string) (*ScheduledMsgModel, error)
+	FindBySendID(ctx context.Context, sendID string, status []int32, pageNumber, showNumber int32) (total int64, msgs []*ScheduledMsgModel, err error)
+	// UpdatePending only updates msgs which are still pending, returns false if the msg is not pending.
+	UpdatePending(ctx context.Context, scheduledMsgID string, update map[string]any) (bool, error)
+	// TakeDue marks one due pending msg, or a sending msg whose lease expired, as sending by leaseID until now+lease
+	// and returns it, returns nil if there is none.
+	TakeDue(ctx context.Context, now int64, lease time.Duration, leaseID string) (*ScheduledMsgModel, error)
+	// UpdateStatus only updates msgs still sending by leaseID, returns false if the lease is lost.
+	UpdateStatus(ctx context.Context, scheduledMsgID string, leaseID string, status int32, serverMsgID string, errMsg string) (bool, error)
+	// Release keeps a msg sending by leaseID as sending but lets it be taken again after leaseExpire,
+	// returns false if the lease is lost.
+	Release(ctx context.Context, scheduledMsgID string, leaseID string, leaseExpire int64, errMsg string) (bool, error)
+}
@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unrelation
+
+import (
+	"context"
+)
+
+const (
+	CThread = "thread"
+)
+
+// ThreadModel indexes the replies of a thread root message, the thread seq of a reply is its position in ReplySeqs plus one.
+type ThreadModel struct {
+	ConversationID string  `bson:"conversation_id"`
+	RootSeq        int64   `bson:"root_seq"`
+	ReplySeqs      []int64 `bson:"reply_seqs"`
+	ReplyCount     int64   `bson:"reply_count"`
+	LastReplySeq   int64   `bson:"last_reply_seq"`
+	LastReplyTime  int64   `bson:"last_reply_time"`
+}
+
+func (ThreadModel) TableName() string {
+	return CThread
+}
+
+type ThreadModelInterface interface {
+	// AddReply ignores replies whose seq is not bigger than the last indexed reply.
+	AddReply(ctx context.Context, conversationID string, rootSeq int64, seq int64, sendTime int64) error
+	// Find does not return ReplySeqs.
+	Find(ctx context.Context, conversationID string, rootSeqs []int64) ([]*ThreadModel, error)
+	// GetReplySeqs returns the msg seqs of thread seqs in [begin, end].
+	GetReplySeqs(ctx context.Context, conversationID string, rootSeq int64, begin, end int64) ([]int64, error)
+}
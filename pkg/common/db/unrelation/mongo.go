@@ -107,6 +107,34 @@ func (m *Mongo) CreateSuperGroupIndex() error {
 	return nil
 }
 
+func (m *Mongo) CreatePinnedMsgIndex() error {
+	if err := m.createMongoIndex(unrelation.CPinnedMsg, true, "conversation_id", "seq"); err != nil {
+		return err
+	}
+	return m.createMongoIndex(unrelation.CPinnedMsgCount, true, "conversation_id")
+}
+
+func (m *Mongo) CreateRetentionPolicyIndex() error {
+	return m.createMongoIndex(unrelation.CRetentionPolicy, true, "target_type", "target_id")
+}
+
+func (m *Mongo) CreateThreadIndex() error {
+	return m.createMongoIndex(unrelation.CThread, true, "conversation_id", "root_seq")
+}
+
+func (m *Mongo) CreateScheduledMsgIndex() error {
+	if err := m.createMongoIndex(unrelation.CScheduledMsg, true, "scheduled_msg_id"); err != nil {
+		return err
+	}
+	if err := m.createMongoIndex(unrelation.CScheduledMsg, false, "status", "send_time"); err != nil {
+		return err
+	}
+	if err := m.createMongoIndex(unrelation.CScheduledMsg, false, "send_id", "send_time"); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (m *Mongo) createMongoIndex(collection string, isUnique bool, keys ...string) error {
 	db := m.db.Database(config.Config.Mongo.Database).Collection(collection)
 	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
@@ -49,8 +49,34 @@ func NewMsgMongoDriver(database *mongo.Database) table.MsgDocModelInterface {
 	return &MsgMongoDriver{MsgCollection: collection}
 }
 
+// unarchivedDoc 归档后的文档只读, 按下标修改消息时不匹配归档文档.
+func unarchivedDoc(docID string) bson.M {
+	return bson.M{"doc_id": docID, "archive": bson.M{"$exists": false}}
+}
+
+// withVersion 修改msgs的同时增加文档的version, 归档时据此判断文档在读取后是否被修改.
+func withVersion(update bson.M) bson.M {
+	update["$inc"] = bson.M{"version": 1}
+	return update
+}
+
+// checkArchived 修改未匹配到文档时, 文档已归档返回ErrMsgArchived, 避免调用方当作文档不存在.
+func (m *MsgMongoDriver) checkArchived(ctx context.Context, docID string, matched int64) error {
+	if matched > 0 {
+		return nil
+	}
+	count, err := m.MsgCollection.CountDocuments(ctx, bson.M{"doc_id": docID, "archive": bson.M{"$exists": true}})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if count > 0 {
+		return errs.ErrMsgArchived.Wrap("doc " + docID + " is archived")
+	}
+	return nil
+}
+
 func (m *MsgMongoDriver) PushMsgsToDoc(ctx context.Context, docID string, msgsToMongo []table.MsgInfoModel) error {
-	return m.MsgCollection.FindOneAndUpdate(ctx, bson.M{"doc_id": docID}, bson.M{"$push": bson.M{"msgs": bson.M{"$each": msgsToMongo}}}).
+	return m.MsgCollection.FindOneAndUpdate(ctx, bson.M{"doc_id": docID}, withVersion(bson.M{"$push": bson.M{"msgs": bson.M{"$each": msgsToMongo}}})).
 		Err()
 }
 
@@ -72,12 +98,29 @@ func (m *MsgMongoDriver) UpdateMsg(
 	} else {
 		field = fmt.Sprintf("msgs.%d.%s", index, key)
 	}
-	filter := bson.M{"doc_id": docID}
-	update := bson.M{"$set": bson.M{field: value}}
+	filter := unarchivedDoc(docID)
+	update := withVersion(bson.M{"$set": bson.M{field: value}})
+	res, err := m.MsgCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	if err := m.checkArchived(ctx, docID, res.MatchedCount); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (m *MsgMongoDriver) UnsetMsg(ctx context.Context, docID string, index int64, key string) (*mongo.UpdateResult, error) {
+	field := fmt.Sprintf("msgs.%d.%s", index, key)
+	filter := unarchivedDoc(docID)
+	update := withVersion(bson.M{"$unset": bson.M{field: ""}})
 	res, err := m.MsgCollection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return nil, utils.Wrap(err, "")
 	}
+	if err := m.checkArchived(ctx, docID, res.MatchedCount); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -95,29 +138,60 @@ func (m *MsgMongoDriver) PushUnique(
 	} else {
 		field = fmt.Sprintf("msgs.%d.%s", index, key)
 	}
-	filter := bson.M{"doc_id": docID}
-	update := bson.M{
+	filter := unarchivedDoc(docID)
+	update := withVersion(bson.M{
 		"$addToSet": bson.M{
 			field: bson.M{"$each": value},
 		},
-	}
+	})
 	res, err := m.MsgCollection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return nil, utils.Wrap(err, "")
 	}
+	if err := m.checkArchived(ctx, docID, res.MatchedCount); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
 func (m *MsgMongoDriver) UpdateMsgContent(ctx context.Context, docID string, index int64, msg []byte) error {
-	_, err := m.MsgCollection.UpdateOne(
+	res, err := m.MsgCollection.UpdateOne(
 		ctx,
-		bson.M{"doc_id": docID},
-		bson.M{"$set": bson.M{fmt.Sprintf("msgs.%d.msg", index): msg}},
+		unarchivedDoc(docID),
+		withVersion(bson.M{"$set": bson.M{fmt.Sprintf("msgs.%d.msg", index): msg}}),
 	)
 	if err != nil {
 		return utils.Wrap(err, "")
 	}
-	return nil
+	return m.checkArchived(ctx, docID, res.MatchedCount)
+}
+
+// EditMsgContent replaces the content of a message and appends its previous content to edit_history,
+// the update only matches while the stored content is still edit.Content so concurrent edits can not be lost.
+func (m *MsgMongoDriver) EditMsgContent(
+	ctx context.Context,
+	docID string,
+	index int64,
+	content string,
+	edit *table.EditModel,
+) (*mongo.UpdateResult, error) {
+	filter := bson.M{
+		"doc_id":  docID,
+		"archive": bson.M{"$exists": false},
+		fmt.Sprintf("msgs.%d.msg.content", index): edit.Content,
+	}
+	update := withVersion(bson.M{
+		"$set":  bson.M{fmt.Sprintf("msgs.%d.msg.content", index): content},
+		"$push": bson.M{fmt.Sprintf("msgs.%d.edit_history", index): edit},
+	})
+	res, err := m.MsgCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	if err := m.checkArchived(ctx, docID, res.MatchedCount); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (m *MsgMongoDriver) UpdateMsgStatusByIndexInOneDoc(
@@ -132,15 +206,15 @@ func (m *MsgMongoDriver) UpdateMsgStatusByIndexInOneDoc(
 	if err != nil {
 		return utils.Wrap(err, "")
 	}
-	_, err = m.MsgCollection.UpdateOne(
+	res, err := m.MsgCollection.UpdateOne(
 		ctx,
-		bson.M{"doc_id": docID},
-		bson.M{"$set": bson.M{fmt.Sprintf("msgs.%d.msg", seqIndex): bytes}},
+		unarchivedDoc(docID),
+		withVersion(bson.M{"$set": bson.M{fmt.Sprintf("msgs.%d.msg", seqIndex): bytes}}),
 	)
 	if err != nil {
 		return utils.Wrap(err, "")
 	}
-	return nil
+	return m.checkArchived(ctx, docID, res.MatchedCount)
 }
 
 func (m *MsgMongoDriver) FindOneByDocID(ctx context.Context, docID string) (*table.MsgDocModel, error) {
@@ -184,6 +258,9 @@ func (m *MsgMongoDriver) GetNewestMsg(ctx context.Context, conversationID string
 		if err != nil {
 			return nil, err
 		}
+		if a := msgDocModel.Archive; a != nil {
+			return &table.MsgInfoModel{Msg: &table.MsgDataModel{Seq: a.MaxSeq, SendTime: a.LastSendTime}}, nil
+		}
 		for i := len(msgDocModel.Msg) - 1; i >= 0; i-- {
 			if msgDocModel.Msg[i].Msg != nil {
 				return msgDocModel.Msg[i], nil
@@ -200,6 +277,9 @@ func (m *MsgMongoDriver) GetOldestMsg(ctx context.Context, conversationID string
 		if err != nil {
 			return nil, err
 		}
+		if a := msgDocModel.Archive; a != nil {
+			return &table.MsgInfoModel{Msg: &table.MsgDataModel{Seq: a.MinSeq}}, nil
+		}
 		for i, v := range msgDocModel.Msg {
 			if v.Msg != nil {
 				return msgDocModel.Msg[i], nil
@@ -218,11 +298,11 @@ func (m *MsgMongoDriver) DeleteMsgsInOneDocByIndex(ctx context.Context, docID st
 			"msg": nil,
 		}
 	}
-	_, err := m.MsgCollection.UpdateMany(ctx, bson.M{"doc_id": docID}, updates)
+	res, err := m.MsgCollection.UpdateMany(ctx, unarchivedDoc(docID), withVersion(updates))
 	if err != nil {
 		return utils.Wrap(err, "")
 	}
-	return nil
+	return m.checkArchived(ctx, docID, res.MatchedCount)
 }
 
 func (m *MsgMongoDriver) DeleteDocs(ctx context.Context, docIDs []string) error {
@@ -267,7 +347,7 @@ func (m *MsgMongoDriver) GetMsgBySeqIndexIn1Doc(
 								{"in", bson.D{
 									{"$cond", bson.D{
 										{"if", bson.D{
-											{"$in", []string{userID, "$$currentMsg.del_list"}},
+											{"$in", bson.A{userID, bson.D{{"$ifNull", bson.A{"$$currentMsg.del_list", bson.A{}}}}}},
 										}},
 										{"then", nil},
 										{"else", "$$currentMsg"},
@@ -303,39 +383,95 @@ func (m *MsgMongoDriver) GetMsgBySeqIndexIn1Doc(
 		if msg == nil || msg.Msg == nil {
 			continue
 		}
-		if msg.Revoke != nil {
-			revokeContent := sdkws.MessageRevokedContent{
-				RevokerID:                   msg.Revoke.UserID,
-				RevokerRole:                 msg.Revoke.Role,
-				ClientMsgID:                 msg.Msg.ClientMsgID,
-				RevokerNickname:             msg.Revoke.Nickname,
-				RevokeTime:                  msg.Revoke.Time,
-				SourceMessageSendTime:       msg.Msg.SendTime,
-				SourceMessageSendID:         msg.Msg.SendID,
-				SourceMessageSenderNickname: msg.Msg.SenderNickname,
-				SessionType:                 msg.Msg.SessionType,
-				Seq:                         msg.Msg.Seq,
-				Ex:                          msg.Msg.Ex,
-			}
-			data, err := json.Marshal(&revokeContent)
-			if err != nil {
-				return nil, err
-			}
-			elem := sdkws.NotificationElem{
-				Detail: string(data),
-			}
-			content, err := json.Marshal(&elem)
-			if err != nil {
-				return nil, err
-			}
-			msg.Msg.ContentType = constant.MsgRevokeNotification
-			msg.Msg.Content = string(content)
+		if err := ConvertRevokeMsg(msg); err != nil {
+			return nil, err
 		}
 		msgs = append(msgs, msg)
 	}
 	return msgs, nil
 }
 
+// ConvertRevokeMsg 将已撤回的消息替换为撤回通知.
+func ConvertRevokeMsg(msg *table.MsgInfoModel) error {
+	if msg.Revoke == nil {
+		return nil
+	}
+	revokeContent := sdkws.MessageRevokedContent{
+		RevokerID:                   msg.Revoke.UserID,
+		RevokerRole:                 msg.Revoke.Role,
+		ClientMsgID:                 msg.Msg.ClientMsgID,
+		RevokerNickname:             msg.Revoke.Nickname,
+		RevokeTime:                  msg.Revoke.Time,
+		SourceMessageSendTime:       msg.Msg.SendTime,
+		SourceMessageSendID:         msg.Msg.SendID,
+		SourceMessageSenderNickname: msg.Msg.SenderNickname,
+		SessionType:                 msg.Msg.SessionType,
+		Seq:                         msg.Msg.Seq,
+		Ex:                          msg.Msg.Ex,
+	}
+	data, err := json.Marshal(&revokeContent)
+	if err != nil {
+		return err
+	}
+	elem := sdkws.NotificationElem{
+		Detail: string(data),
+	}
+	content, err := json.Marshal(&elem)
+	if err != nil {
+		return err
+	}
+	msg.Msg.ContentType = constant.MsgRevokeNotification
+	msg.Msg.Content = string(content)
+	return nil
+}
+
+func (m *MsgMongoDriver) FindArchivableDocIDs(ctx context.Context, before int64, limit int64) ([]string, error) {
+	lastSendTime := fmt.Sprintf("msgs.%d.msg.send_time", table.MsgDocModel{}.GetSingleGocMsgNum()-1)
+	filter := bson.M{
+		"archive":    bson.M{"$exists": false},
+		lastSendTime: bson.M{"$lt": before},
+	}
+	opts := options.Find().SetProjection(bson.M{"_id": 0, "doc_id": 1}).SetLimit(limit)
+	cursor, err := m.MsgCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var docs []table.MsgDocModel
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	docIDs := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		docIDs = append(docIDs, doc.DocID)
+	}
+	return docIDs, nil
+}
+
+func (m *MsgMongoDriver) ArchiveDoc(ctx context.Context, docID string, version int64, archive *table.MsgDocArchiveModel) (bool, error) {
+	filter := unarchivedDoc(docID)
+	if version == 0 {
+		// 增加version字段之前写入的文档没有该字段
+		filter["version"] = bson.M{"$in": bson.A{0, nil}}
+	} else {
+		filter["version"] = version
+	}
+	update := bson.M{"$set": bson.M{"archive": archive, "msgs": bson.A{}}}
+	res, err := m.MsgCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (m *MsgMongoDriver) GetDocArchive(ctx context.Context, docID string) (*table.MsgDocArchiveModel, error) {
+	doc := &table.MsgDocModel{}
+	opts := options.FindOne().SetProjection(bson.M{"_id": 0, "archive": 1})
+	if err := m.MsgCollection.FindOne(ctx, bson.M{"doc_id": docID}, opts).Decode(doc); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return doc.Archive, nil
+}
+
 func (m *MsgMongoDriver) IsExistDocID(ctx context.Context, docID string) (bool, error) {
 	count, err := m.MsgCollection.CountDocuments(ctx, bson.M{"doc_id": docID})
 	if err != nil {
@@ -353,23 +489,27 @@ func (m *MsgMongoDriver) MarkSingleChatMsgsAsRead(
 	updates := []mongo.WriteModel{}
 	for _, index := range indexes {
 		filter := bson.M{
-			"doc_id": docID,
+			"doc_id":  docID,
+			"archive": bson.M{"$exists": false},
 			fmt.Sprintf("msgs.%d.msg.send_id", index): bson.M{
 				"$ne": userID,
 			},
 		}
-		update := bson.M{
+		update := withVersion(bson.M{
 			"$set": bson.M{
 				fmt.Sprintf("msgs.%d.is_read", index): true,
 			},
-		}
+		})
 		updateModel := mongo.NewUpdateManyModel().
 			SetFilter(filter).
 			SetUpdate(update)
 		updates = append(updates, updateModel)
 	}
-	_, err := m.MsgCollection.BulkWrite(ctx, updates)
-	return err
+	res, err := m.MsgCollection.BulkWrite(ctx, updates)
+	if err != nil {
+		return err
+	}
+	return m.checkArchived(ctx, docID, res.MatchedCount)
 }
 
 // RangeUserSendCount
@@ -1171,33 +1311,8 @@ func (m *MsgMongoDriver) searchMessage(ctx context.Context, req *msg.SearchMessa
 			if msg == nil || msg.Msg == nil {
 				continue
 			}
-			if msg.Revoke != nil {
-				revokeContent := sdkws.MessageRevokedContent{
-					RevokerID:                   msg.Revoke.UserID,
-					RevokerRole:                 msg.Revoke.Role,
-					ClientMsgID:                 msg.Msg.ClientMsgID,
-					RevokerNickname:             msg.Revoke.Nickname,
-					RevokeTime:                  msg.Revoke.Time,
-					SourceMessageSendTime:       msg.Msg.SendTime,
-					SourceMessageSendID:         msg.Msg.SendID,
-					SourceMessageSenderNickname: msg.Msg.SenderNickname,
-					SessionType:                 msg.Msg.SessionType,
-					Seq:                         msg.Msg.Seq,
-					Ex:                          msg.Msg.Ex,
-				}
-				data, err := json.Marshal(&revokeContent)
-				if err != nil {
-					return 0, nil, err
-				}
-				elem := sdkws.NotificationElem{
-					Detail: string(data),
-				}
-				content, err := json.Marshal(&elem)
-				if err != nil {
-					return 0, nil, err
-				}
-				msg.Msg.ContentType = constant.MsgRevokeNotification
-				msg.Msg.Content = string(content)
+			if err := ConvertRevokeMsg(msg); err != nil {
+				return 0, nil, err
 			}
 			msgs = append(msgs, msg)
 		}
@@ -0,0 +1,142 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unrelation
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+func NewPinnedMsgMongoDriver(database *mongo.Database) unrelation.PinnedMsgModelInterface {
+	return &PinnedMsgMongoDriver{
+		collection:      database.Collection(unrelation.CPinnedMsg),
+		countCollection: database.Collection(unrelation.CPinnedMsgCount),
+	}
+}
+
+type PinnedMsgMongoDriver struct {
+	collection      *mongo.Collection
+	countCollection *mongo.Collection
+}
+
+func (p *PinnedMsgMongoDriver) Create(ctx context.Context, pinnedMsg *unrelation.PinnedMsgModel) error {
+	_, err := p.collection.InsertOne(ctx, pinnedMsg)
+	if mongo.IsDuplicateKeyError(err) {
+		return errs.ErrArgs.Wrap("msg already pinned")
+	}
+	return utils.Wrap(err, "")
+}
+
+func (p *PinnedMsgMongoDriver) Delete(ctx context.Context, conversationID string, seq int64) (bool, error) {
+	res, err := p.collection.DeleteOne(ctx, bson.M{"conversation_id": conversationID, "seq": seq})
+	if err != nil {
+		return false, utils.Wrap(err, "")
+	}
+	return res.DeletedCount > 0, nil
+}
+
+func (p *PinnedMsgMongoDriver) Count(ctx context.Context, conversationID string) (int64, error) {
+	count, err := p.collection.CountDocuments(ctx, bson.M{"conversation_id": conversationID})
+	return count, utils.Wrap(err, "")
+}
+
+func (p *PinnedMsgMongoDriver) IncrCount(ctx context.Context, conversationID string, maxNum int64) (bool, error) {
+	for i := 0; i < 2; i++ {
+		res, err := p.countCollection.UpdateOne(
+			ctx,
+			bson.M{"conversation_id": conversationID, "count": bson.M{"$lt": maxNum}},
+			bson.M{"$inc": bson.M{"count": 1}},
+		)
+		if err != nil {
+			return false, utils.Wrap(err, "")
+		}
+		if res.MatchedCount > 0 {
+			return true, nil
+		}
+		if i > 0 {
+			break
+		}
+		if err := p.recount(ctx, conversationID); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// recount 计数不存在或已达上限时用已置顶数量重新计算, 修正置顶被直接删除(如清理消息)后计数的偏差.
+// 计数不存在时并发初始化由唯一索引保证只有一条, 已存在时只在计数未被并发修改时更新.
+func (p *PinnedMsgMongoDriver) recount(ctx context.Context, conversationID string) error {
+	var doc struct {
+		Count int64 `bson:"count"`
+	}
+	err := p.countCollection.FindOne(ctx, bson.M{"conversation_id": conversationID}).Decode(&doc)
+	exist := err == nil
+	if err != nil && err != mongo.ErrNoDocuments {
+		return utils.Wrap(err, "")
+	}
+	count, err := p.Count(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		_, err = p.countCollection.InsertOne(ctx, bson.M{"conversation_id": conversationID, "count": count})
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return utils.Wrap(err, "")
+		}
+		return nil
+	}
+	if count >= doc.Count {
+		return nil
+	}
+	_, err = p.countCollection.UpdateOne(
+		ctx,
+		bson.M{"conversation_id": conversationID, "count": doc.Count},
+		bson.M{"$set": bson.M{"count": count}},
+	)
+	return utils.Wrap(err, "")
+}
+
+func (p *PinnedMsgMongoDriver) DecrCount(ctx context.Context, conversationID string) error {
+	_, err := p.countCollection.UpdateOne(
+		ctx,
+		bson.M{"conversation_id": conversationID, "count": bson.M{"$gt": 0}},
+		bson.M{"$inc": bson.M{"count": -1}},
+	)
+	return utils.Wrap(err, "")
+}
+
+func (p *PinnedMsgMongoDriver) Find(ctx context.Context, conversationID string) ([]*unrelation.PinnedMsgModel, error) {
+	cursor, err := p.collection.Find(
+		ctx,
+		bson.M{"conversation_id": conversationID},
+		options.Find().SetSort(bson.M{"pin_time": -1}),
+	)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	defer cursor.Close(ctx)
+	var pinnedMsgs []*unrelation.PinnedMsgModel
+	if err := cursor.All(ctx, &pinnedMsgs); err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return pinnedMsgs, nil
+}
@@ -0,0 +1,87 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unrelation
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+func NewRetentionPolicyMongoDriver(database *mongo.Database) unrelation.RetentionPolicyModelInterface {
+	return &RetentionPolicyMongoDriver{collection: database.Collection(unrelation.CRetentionPolicy)}
+}
+
+type RetentionPolicyMongoDriver struct {
+	collection *mongo.Collection
+}
+
+func (r *RetentionPolicyMongoDriver) Upsert(ctx context.Context, policy *unrelation.RetentionPolicyModel) error {
+	filter := bson.M{"target_type": policy.TargetType, "target_id": policy.TargetID}
+	update := bson.M{
+		"$set": bson.M{
+			"max_age":     policy.MaxAge,
+			"max_msg_num": policy.MaxMsgNum,
+			"legal_hold":  policy.LegalHold,
+			"op_user_id":  policy.OpUserID,
+			"update_time": policy.UpdateTime,
+		},
+		"$setOnInsert": bson.M{"create_time": policy.CreateTime},
+	}
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return utils.Wrap(err, "")
+}
+
+func (r *RetentionPolicyMongoDriver) Delete(ctx context.Context, targetType int32, targetID string) (bool, error) {
+	res, err := r.collection.DeleteOne(ctx, bson.M{"target_type": targetType, "target_id": targetID})
+	if err != nil {
+		return false, utils.Wrap(err, "")
+	}
+	return res.DeletedCount > 0, nil
+}
+
+func (r *RetentionPolicyMongoDriver) Find(
+	ctx context.Context,
+	targetType int32,
+	pageNumber, showNumber int32,
+) (int64, []*unrelation.RetentionPolicyModel, error) {
+	filter := bson.M{}
+	if targetType != 0 {
+		filter["target_type"] = targetType
+	}
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, nil, utils.Wrap(err, "")
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "target_type", Value: 1}, {Key: "target_id", Value: 1}})
+	if showNumber > 0 {
+		opts.SetSkip(int64(pageNumber-1) * int64(showNumber)).SetLimit(int64(showNumber))
+	}
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, nil, utils.Wrap(err, "")
+	}
+	defer cursor.Close(ctx)
+	var policies []*unrelation.RetentionPolicyModel
+	if err := cursor.All(ctx, &policies); err != nil {
+		return 0, nil, utils.Wrap(err, "")
+	}
+	return total, policies, nil
+}
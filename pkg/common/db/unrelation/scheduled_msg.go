@@ -0,0 +1,167 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unrelation
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+func NewScheduledMsgMongoDriver(database *mongo.Database) unrelation.ScheduledMsgModelInterface {
+	return &ScheduledMsgMongoDriver{collection: database.Collection(unrelation.CScheduledMsg)}
+}
+
+type ScheduledMsgMongoDriver struct {
+	collection *mongo.Collection
+}
+
+func (s *ScheduledMsgMongoDriver) Create(ctx context.Context, msg *unrelation.ScheduledMsgModel) error {
+	_, err := s.collection.InsertOne(ctx, msg)
+	return utils.Wrap(err, "")
+}
+
+func (s *ScheduledMsgMongoDriver) Take(ctx context.Context, scheduledMsgID string) (*unrelation.ScheduledMsgModel, error) {
+	var msg unrelation.ScheduledMsgModel
+	if err := s.collection.FindOne(ctx, bson.M{"scheduled_msg_id": scheduledMsgID}).Decode(&msg); err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return &msg, nil
+}
+
+func (s *ScheduledMsgMongoDriver) FindBySendID(
+	ctx context.Context,
+	sendID string,
+	status []int32,
+	pageNumber, showNumber int32,
+) (int64, []*unrelation.ScheduledMsgModel, error) {
+	filter := bson.M{"send_id": sendID}
+	if len(status) > 0 {
+		filter["status"] = bson.M{"$in": status}
+	}
+	total, err := s.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, nil, utils.Wrap(err, "")
+	}
+	opts := options.Find().SetSort(bson.M{"send_time": 1})
+	if showNumber > 0 {
+		opts.SetSkip(int64(pageNumber-1) * int64(showNumber)).SetLimit(int64(showNumber))
+	}
+	cursor, err := s.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, nil, utils.Wrap(err, "")
+	}
+	defer cursor.Close(ctx)
+	var msgs []*unrelation.ScheduledMsgModel
+	if err := cursor.All(ctx, &msgs); err != nil {
+		return 0, nil, utils.Wrap(err, "")
+	}
+	return total, msgs, nil
+}
+
+func (s *ScheduledMsgMongoDriver) UpdatePending(ctx context.Context, scheduledMsgID string, update map[string]any) (bool, error) {
+	set := bson.M{"update_time": time.Now()}
+	for k, v := range update {
+		set[k] = v
+	}
+	res, err := s.collection.UpdateOne(
+		ctx,
+		bson.M{"scheduled_msg_id": scheduledMsgID, "status": constant.ScheduledMsgPending},
+		bson.M{"$set": set},
+	)
+	if err != nil {
+		return false, utils.Wrap(err, "")
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (s *ScheduledMsgMongoDriver) TakeDue(ctx context.Context, now int64, lease time.Duration, leaseID string) (*unrelation.ScheduledMsgModel, error) {
+	var msg unrelation.ScheduledMsgModel
+	err := s.collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"$or": []bson.M{
+			{"status": constant.ScheduledMsgPending, "send_time": bson.M{"$lte": now}},
+			// 发送中的实例崩溃或超时未写回结果
+			{"status": constant.ScheduledMsgSending, "lease_expire": bson.M{"$lt": now}},
+		}},
+		bson.M{
+			"$set": bson.M{
+				"status":       constant.ScheduledMsgSending,
+				"lease_expire": now + lease.Milliseconds(),
+				"lease_id":     leaseID,
+				"update_time":  time.Now(),
+			},
+			"$inc": bson.M{"attempts": 1},
+		},
+		options.FindOneAndUpdate().SetSort(bson.M{"send_time": 1}).SetReturnDocument(options.After),
+	).Decode(&msg)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return &msg, nil
+}
+
+func (s *ScheduledMsgMongoDriver) UpdateStatus(
+	ctx context.Context,
+	scheduledMsgID string,
+	leaseID string,
+	status int32,
+	serverMsgID string,
+	errMsg string,
+) (bool, error) {
+	return s.updateLeased(ctx, scheduledMsgID, leaseID, bson.M{
+		"status":        status,
+		"server_msg_id": serverMsgID,
+		"err_msg":       errMsg,
+		"update_time":   time.Now(),
+	})
+}
+
+func (s *ScheduledMsgMongoDriver) Release(
+	ctx context.Context,
+	scheduledMsgID string,
+	leaseID string,
+	leaseExpire int64,
+	errMsg string,
+) (bool, error) {
+	return s.updateLeased(ctx, scheduledMsgID, leaseID, bson.M{
+		"lease_expire": leaseExpire,
+		"err_msg":      errMsg,
+		"update_time":  time.Now(),
+	})
+}
+
+// updateLeased 只修改仍由leaseID发送中的消息, 租约过期后被其他实例重新取出时不覆盖其结果.
+func (s *ScheduledMsgMongoDriver) updateLeased(ctx context.Context, scheduledMsgID string, leaseID string, set bson.M) (bool, error) {
+	res, err := s.collection.UpdateOne(
+		ctx,
+		bson.M{"scheduled_msg_id": scheduledMsgID, "status": constant.ScheduledMsgSending, "lease_id": leaseID},
+		bson.M{"$set": set},
+	)
+	if err != nil {
+		return false, utils.Wrap(err, "")
+	}
+	return res.MatchedCount > 0, nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unrelation
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/db/table/unrelation"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+func NewThreadMongoDriver(database *mongo.Database) unrelation.ThreadModelInterface {
+	return &ThreadMongoDriver{collection: database.Collection(unrelation.CThread)}
+}
+
+type ThreadMongoDriver struct {
+	collection *mongo.Collection
+}
+
+func (t *ThreadMongoDriver) AddReply(ctx context.Context, conversationID string, rootSeq int64, seq int64, sendTime int64) error {
+	filter := bson.M{"conversation_id": conversationID, "root_seq": rootSeq, "last_reply_seq": bson.M{"$lt": seq}}
+	update := bson.M{
+		"$push": bson.M{"reply_seqs": seq},
+		"$inc":  bson.M{"reply_count": 1},
+		"$set":  bson.M{"last_reply_seq": seq, "last_reply_time": sendTime},
+	}
+	_, err := t.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		// 文档存在但last_reply_seq不小于seq, 说明已经索引过
+		return nil
+	}
+	return utils.Wrap(err, "")
+}
+
+func (t *ThreadMongoDriver) Find(ctx context.Context, conversationID string, rootSeqs []int64) ([]*unrelation.ThreadModel, error) {
+	cursor, err := t.collection.Find(
+		ctx,
+		bson.M{"conversation_id": conversationID, "root_seq": bson.M{"$in": rootSeqs}},
+		options.Find().SetProjection(bson.M{"reply_seqs": 0}),
+	)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	defer cursor.Close(ctx)
+	var threads []*unrelation.ThreadModel
+	if err := cursor.All(ctx, &threads); err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return threads, nil
+}
+
+func (t *ThreadMongoDriver) GetReplySeqs(ctx context.Context, conversationID string, rootSeq int64, begin, end int64) ([]int64, error) {
+	var thread unrelation.ThreadModel
+	err := t.collection.FindOne(
+		ctx,
+		bson.M{"conversation_id": conversationID, "root_seq": rootSeq},
+		options.FindOne().SetProjection(bson.M{"reply_seqs": bson.M{"$slice": []int64{begin - 1, end - begin + 1}}}),
+	).Decode(&thread)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return thread.ReplySeqs, nil
+}
@@ -16,12 +16,19 @@ package kafka
 
 import (
 	"context"
+	"time"
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
 
 	"github.com/Shopify/sarama"
 )
 
+const (
+	minConsumeBackoff = time.Second
+	maxConsumeBackoff = 30 * time.Second
+)
+
 type MConsumerGroup struct {
 	sarama.ConsumerGroup
 	groupID string
@@ -34,33 +41,51 @@ type MConsumerGroupConfig struct {
 	IsReturnErr    bool
 }
 
-func NewMConsumerGroup(consumerConfig *MConsumerGroupConfig, topics, addrs []string, groupID string) *MConsumerGroup {
+func NewMConsumerGroup(consumerConfig *MConsumerGroupConfig, topics, addrs []string, groupID string) (*MConsumerGroup, error) {
 	config := sarama.NewConfig()
 	config.Version = consumerConfig.KafkaVersion
 	config.Consumer.Offsets.Initial = consumerConfig.OffsetsInitial
 	config.Consumer.Return.Errors = consumerConfig.IsReturnErr
 	consumerGroup, err := sarama.NewConsumerGroup(addrs, groupID, config)
 	if err != nil {
-		panic(err.Error())
+		return nil, utils.Wrap(err, "new kafka consumer group failed")
 	}
 	return &MConsumerGroup{
 		consumerGroup,
 		groupID,
 		topics,
-	}
+	}, nil
 }
 
 func (mc *MConsumerGroup) GetContextFromMsg(cMsg *sarama.ConsumerMessage) context.Context {
 	return GetContextWithMQHeader(cMsg.Headers)
 }
 
-func (mc *MConsumerGroup) RegisterHandleAndConsumer(handler sarama.ConsumerGroupHandler) {
-	log.ZDebug(context.Background(), "register consumer group", "groupID", mc.groupID)
-	ctx := context.Background()
+// RegisterHandleAndConsumer 持续消费直到ctx取消, 等待handler处理完当前消息后关闭消费组并提交已标记的offset.
+// 消费出错(broker不可用、rebalance失败等)时记录日志并退避重试.
+func (mc *MConsumerGroup) RegisterHandleAndConsumer(ctx context.Context, handler sarama.ConsumerGroupHandler) {
+	log.ZDebug(ctx, "register consumer group", "groupID", mc.groupID)
+	backoff := minConsumeBackoff
 	for {
 		err := mc.ConsumerGroup.Consume(ctx, mc.topics, handler)
-		if err != nil {
-			panic(err.Error())
+		if ctx.Err() != nil {
+			break
+		}
+		if err == nil {
+			backoff = minConsumeBackoff
+			continue
 		}
+		log.ZError(ctx, "consume failed, retry later", err, "groupID", mc.groupID, "topics", mc.topics, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxConsumeBackoff {
+			backoff = maxConsumeBackoff
+		}
+	}
+	if err := mc.ConsumerGroup.Close(); err != nil {
+		log.ZError(ctx, "close consumer group failed", err, "groupID", mc.groupID)
 	}
+	log.ZInfo(ctx, "consumer group closed", "groupID", mc.groupID)
 }
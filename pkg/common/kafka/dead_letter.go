@@ -0,0 +1,145 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"time"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const deadLetterReadTimeout = 10 * time.Second
+
+// DeadLetterReader 从消费组已提交的位置读取死信topic, 供命令行工具查看、重放和丢弃.
+// 不加入消费组, 只使用消费组的offset, 同一时间只应有一个工具在处理同一个topic.
+type DeadLetterReader struct {
+	topic         string
+	client        sarama.Client
+	consumer      sarama.Consumer
+	offsetManager sarama.OffsetManager
+}
+
+func NewDeadLetterReader(addrs []string, topic, groupID string) (*DeadLetterReader, error) {
+	conf := sarama.NewConfig()
+	conf.Version = sarama.V2_0_0_0
+	conf.Consumer.Offsets.Initial = sarama.OffsetOldest
+	conf.Consumer.Offsets.AutoCommit.Enable = false
+	if config.Config.Kafka.Username != "" && config.Config.Kafka.Password != "" {
+		conf.Net.SASL.Enable = true
+		conf.Net.SASL.User = config.Config.Kafka.Username
+		conf.Net.SASL.Password = config.Config.Kafka.Password
+	}
+	client, err := sarama.NewClient(addrs, conf)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, utils.Wrap(err, "")
+	}
+	offsetManager, err := sarama.NewOffsetManagerFromClient(groupID, client)
+	if err != nil {
+		consumer.Close()
+		client.Close()
+		return nil, utils.Wrap(err, "")
+	}
+	return &DeadLetterReader{topic: topic, client: client, consumer: consumer, offsetManager: offsetManager}, nil
+}
+
+// Range 按分区依次读取已提交位置之后、调用时已存在的消息, 最多limit条(<=0不限制).
+// commit为true时提交fn处理成功的消息的offset, fn返回错误时停止并返回已处理的条数.
+func (r *DeadLetterReader) Range(limit int, commit bool, fn func(msg *sarama.ConsumerMessage) error) (n int, err error) {
+	partitions, err := r.client.Partitions(r.topic)
+	if err != nil {
+		return 0, utils.Wrap(err, "")
+	}
+	if commit {
+		defer r.offsetManager.Commit()
+	}
+	for _, partition := range partitions {
+		if limit > 0 && n >= limit {
+			break
+		}
+		var count int
+		count, err = r.rangePartition(partition, limit-n, limit > 0, commit, fn)
+		n += count
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (r *DeadLetterReader) rangePartition(
+	partition int32,
+	limit int,
+	limited bool,
+	commit bool,
+	fn func(msg *sarama.ConsumerMessage) error,
+) (n int, err error) {
+	pom, err := r.offsetManager.ManagePartition(r.topic, partition)
+	if err != nil {
+		return 0, utils.Wrap(err, "")
+	}
+	// 关闭了自动提交, Close会阻塞到下一次Commit, 这里只标记结束
+	defer pom.AsyncClose()
+	next, _ := pom.NextOffset()
+	oldest, err := r.client.GetOffset(r.topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, utils.Wrap(err, "")
+	}
+	newest, err := r.client.GetOffset(r.topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, utils.Wrap(err, "")
+	}
+	if next < oldest {
+		next = oldest
+	}
+	if next >= newest {
+		return 0, nil
+	}
+	pc, err := r.consumer.ConsumePartition(r.topic, partition, next)
+	if err != nil {
+		return 0, utils.Wrap(err, "")
+	}
+	defer pc.Close()
+	for next < newest && (!limited || n < limit) {
+		var msg *sarama.ConsumerMessage
+		select {
+		case msg = <-pc.Messages():
+		case <-time.After(deadLetterReadTimeout):
+			return n, utils.Wrap(sarama.ErrRequestTimedOut, "read dead letter")
+		}
+		if err := fn(msg); err != nil {
+			return n, err
+		}
+		n++
+		next = msg.Offset + 1
+		if commit {
+			pom.MarkOffset(next, "")
+		}
+	}
+	return n, nil
+}
+
+func (r *DeadLetterReader) Close() error {
+	r.offsetManager.Close()
+	r.consumer.Close()
+	return r.client.Close()
+}
@@ -44,8 +44,8 @@ type Producer struct {
 	producer sarama.SyncProducer
 }
 
-// NewKafkaProducer Initialize kafka producer.
-func NewKafkaProducer(addr []string, topic string) *Producer {
+// NewKafkaProducer Initialize kafka producer, 重试maxRetry次后仍无法连接时返回错误.
+func NewKafkaProducer(addr []string, topic string) (*Producer, error) {
 	p := Producer{}
 	p.config = sarama.NewConfig()             // Instantiate a sarama Config
 	p.config.Producer.Return.Successes = true // Whether to enable the successes channel to be notified after the message is sent successfully
@@ -65,7 +65,7 @@ func NewKafkaProducer(addr []string, topic string) *Producer {
 		producer, err = sarama.NewSyncProducer(p.addr, p.config) // Initialize the client
 		if err == nil {
 			p.producer = producer
-			return &p
+			return &p, nil
 		}
 		//TODO If the password is wrong, exit directly
 		//if packetErr, ok := err.(*sarama.PacketEncodingError); ok {
@@ -77,11 +77,7 @@ func NewKafkaProducer(addr []string, topic string) *Producer {
 		//}
 		time.Sleep(time.Duration(1) * time.Second)
 	}
-	if err != nil {
-		panic(err.Error())
-	}
-	p.producer = producer
-	return &p
+	return nil, utils.Wrap(err, "new kafka producer failed")
 }
 
 func GetMQHeaderWithContext(ctx context.Context) ([]sarama.RecordHeader, error) {
@@ -0,0 +1,119 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/kafka"
+)
+
+func newKafkaProducer(topic string) (Producer, error) {
+	return kafka.NewKafkaProducer(config.Config.Kafka.Addr, topic)
+}
+
+type kafkaConsumerGroup struct {
+	group *kafka.MConsumerGroup
+}
+
+func newKafkaConsumerGroup(topics []string, groupID string) (ConsumerGroup, error) {
+	group, err := kafka.NewMConsumerGroup(&kafka.MConsumerGroupConfig{
+		KafkaVersion:   sarama.V2_0_0_0,
+		OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false,
+	}, topics, config.Config.Kafka.Addr, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaConsumerGroup{group: group}, nil
+}
+
+func (k *kafkaConsumerGroup) RegisterHandleAndConsumer(ctx context.Context, handler ConsumerGroupHandler) {
+	k.group.RegisterHandleAndConsumer(ctx, &kafkaHandler{handler: handler})
+}
+
+func (k *kafkaConsumerGroup) GetContextFromMsg(msg *Message) context.Context {
+	return GetContextWithMQHeader(msg.Headers)
+}
+
+// kafkaHandler 将sarama的回调转换为mq的类型.
+type kafkaHandler struct {
+	handler ConsumerGroupHandler
+}
+
+func (h *kafkaHandler) Setup(sess sarama.ConsumerGroupSession) error {
+	return h.handler.Setup(&kafkaSession{sess: sess})
+}
+
+func (h *kafkaHandler) Cleanup(sess sarama.ConsumerGroupSession) error {
+	return h.handler.Cleanup(&kafkaSession{sess: sess})
+}
+
+func (h *kafkaHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	msgs := make(chan *Message)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(msgs)
+		for m := range claim.Messages() {
+			select {
+			case msgs <- fromSarama(m):
+			case <-done:
+				return
+			}
+		}
+	}()
+	return h.handler.ConsumeClaim(&kafkaSession{sess: sess}, &kafkaClaim{claim: claim, msgs: msgs})
+}
+
+type kafkaSession struct {
+	sess sarama.ConsumerGroupSession
+}
+
+func (s *kafkaSession) MarkMessage(msg *Message, metadata string) {
+	s.sess.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, metadata)
+}
+
+func (s *kafkaSession) Context() context.Context {
+	return s.sess.Context()
+}
+
+type kafkaClaim struct {
+	claim sarama.ConsumerGroupClaim
+	msgs  chan *Message
+}
+
+func (c *kafkaClaim) Topic() string              { return c.claim.Topic() }
+func (c *kafkaClaim) Partition() int32           { return c.claim.Partition() }
+func (c *kafkaClaim) HighWaterMarkOffset() int64 { return c.claim.HighWaterMarkOffset() }
+func (c *kafkaClaim) Messages() <-chan *Message  { return c.msgs }
+
+func fromSarama(m *sarama.ConsumerMessage) *Message {
+	headers := make([]*Header, 0, len(m.Headers))
+	for _, h := range m.Headers {
+		headers = append(headers, &Header{Key: h.Key, Value: h.Value})
+	}
+	return &Message{
+		Topic:     m.Topic,
+		Key:       m.Key,
+		Value:     m.Value,
+		Headers:   headers,
+		Partition: m.Partition,
+		Offset:    m.Offset,
+		Timestamp: m.Timestamp,
+	}
+}
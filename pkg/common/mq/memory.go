@@ -0,0 +1,294 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+// memoryBufferSize 每个消费组在每个topic上缓存的消息数, 写满后生产者阻塞.
+const memoryBufferSize = 10000
+
+// memoryStartupWindow topic创建后的启动阶段, 期间订阅的消费组会收到之前发送的全部消息.
+const memoryStartupWindow = time.Minute
+
+// memoryBroker 进程内的消息队列, 每个topic单分区; 同一消费组的多个消费者共享消息, 不同消费组各自收到全部消息.
+// 消息只保存在内存中, 进程退出后未消费的消息丢失.
+type memoryBroker struct {
+	lock   sync.Mutex
+	topics map[string]*memoryTopic
+}
+
+var broker = &memoryBroker{topics: make(map[string]*memoryTopic)}
+
+func (b *memoryBroker) topic(name string) *memoryTopic {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	t, ok := b.topics[name]
+	if !ok {
+		t = &memoryTopic{
+			name:    name,
+			created: time.Now(),
+			groups:  make(map[string]chan *Message),
+		}
+		b.topics[name] = t
+	}
+	return t
+}
+
+type memoryTopic struct {
+	name    string
+	lock    sync.Mutex
+	offset  int64
+	groups  map[string]chan *Message
+	created time.Time
+	// history 启动阶段或还没有消费组订阅时发送的消息, 复制给启动阶段订阅的每个消费组, 避免组件启动顺序不同导致丢消息
+	history     []*Message
+	historyDone bool
+}
+
+// keepHistory 是否还需要记录history, 调用方持有锁.
+func (t *memoryTopic) keepHistory() bool {
+	if len(t.groups) == 0 {
+		return true
+	}
+	return !t.historyDone && time.Since(t.created) < memoryStartupWindow
+}
+
+func (t *memoryTopic) subscribe(groupID string) chan *Message {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	ch, ok := t.groups[groupID]
+	if ok {
+		return ch
+	}
+	ch = make(chan *Message, memoryBufferSize)
+	for _, m := range t.history {
+		ch <- m
+	}
+	t.groups[groupID] = ch
+	if !t.keepHistory() {
+		t.history = nil
+		t.historyDone = true
+	}
+	return ch
+}
+
+// subscribed topic是否已有消费组订阅.
+func (t *memoryTopic) subscribed() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return len(t.groups) > 0
+}
+
+func (t *memoryTopic) publish(ctx context.Context, msg *Message) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	msg.Offset = t.offset
+	t.offset++
+	if t.keepHistory() {
+		switch {
+		case len(t.history) < memoryBufferSize:
+			t.history = append(t.history, msg)
+		case len(t.groups) == 0:
+			return utils.Wrap(errs.ErrInternalServer, "memory mq backlog is full, topic "+t.name)
+		default:
+			// 启动阶段消息过多, 之后订阅的消费组不再补发, 避免只补发一部分
+			log.ZWarn(ctx, "memory mq history is full, stop keeping history", nil, "topic", t.name)
+			t.history = nil
+			t.historyDone = true
+		}
+	}
+	for _, ch := range t.groups {
+		select {
+		case ch <- msg:
+		case <-ctx.Done():
+			return utils.Wrap(ctx.Err(), "memory mq buffer is full, topic "+t.name)
+		}
+	}
+	return nil
+}
+
+func (t *memoryTopic) highWaterMark() int64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.offset
+}
+
+// WaitMemorySubscribed 等待内存队列的topics都有消费组订阅, 超时返回false.
+func WaitMemorySubscribed(timeout time.Duration, topics ...string) bool {
+	deadline := time.Now().Add(timeout)
+	for _, topic := range topics {
+		t := broker.topic(topic)
+		for !t.subscribed() {
+			if time.Now().After(deadline) {
+				return false
+			}
+			time.Sleep(time.Millisecond * 100)
+		}
+	}
+	return true
+}
+
+type memoryProducer struct {
+	topic *memoryTopic
+}
+
+func newMemoryProducer(topic string) Producer {
+	return &memoryProducer{topic: broker.topic(topic)}
+}
+
+func (p *memoryProducer) SendMessage(ctx context.Context, key string, msg proto.Message) (int32, int64, error) {
+	log.ZDebug(ctx, "SendMessage", "msg", msg, "topic", p.topic.name, "key", key)
+	value, headers, err := marshal(ctx, key, msg)
+	if err != nil {
+		return 0, 0, err
+	}
+	m := &Message{
+		Topic:     p.topic.name,
+		Key:       []byte(key),
+		Value:     value,
+		Headers:   headers,
+		Timestamp: time.Now(),
+	}
+	if err := p.topic.publish(ctx, m); err != nil {
+		return 0, 0, err
+	}
+	return m.Partition, m.Offset, nil
+}
+
+type memoryConsumerGroup struct {
+	groupID string
+	topics  []*memoryTopic
+}
+
+func newMemoryConsumerGroup(topics []string, groupID string) ConsumerGroup {
+	g := &memoryConsumerGroup{groupID: groupID}
+	for _, topic := range topics {
+		g.topics = append(g.topics, broker.topic(topic))
+	}
+	return g
+}
+
+func (g *memoryConsumerGroup) GetContextFromMsg(msg *Message) context.Context {
+	return GetContextWithMQHeader(msg.Headers)
+}
+
+// RegisterHandleAndConsumer 每个topic一个claim, 与kafka一样在handler出错时退避后重新开始会话.
+func (g *memoryConsumerGroup) RegisterHandleAndConsumer(ctx context.Context, handler ConsumerGroupHandler) {
+	log.ZDebug(ctx, "register memory consumer group", "groupID", g.groupID)
+	subs := make([]chan *Message, len(g.topics))
+	for i, t := range g.topics {
+		subs[i] = t.subscribe(g.groupID)
+	}
+	for {
+		err := g.consume(ctx, handler, subs)
+		if ctx.Err() != nil {
+			break
+		}
+		log.ZError(ctx, "memory consume failed, retry later", err, "groupID", g.groupID)
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+		}
+	}
+	log.ZInfo(ctx, "memory consumer group closed", "groupID", g.groupID)
+}
+
+func (g *memoryConsumerGroup) consume(ctx context.Context, handler ConsumerGroupHandler, subs []chan *Message) error {
+	sessCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sess := &memorySession{ctx: sessCtx}
+	if err := handler.Setup(sess); err != nil {
+		return err
+	}
+	var (
+		wg       sync.WaitGroup
+		firstErr atomic.Value
+	)
+	for i, t := range g.topics {
+		claim := &memoryClaim{topic: t, msgs: make(chan *Message)}
+		go claim.forward(sessCtx, subs[i])
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := handler.ConsumeClaim(sess, claim); err != nil {
+				firstErr.CompareAndSwap(nil, err)
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+	if err := handler.Cleanup(sess); err != nil {
+		return err
+	}
+	if err, ok := firstErr.Load().(error); ok {
+		return err
+	}
+	return ctx.Err()
+}
+
+type memorySession struct {
+	ctx context.Context
+}
+
+// MarkMessage 内存队列中的消息被取出即视为已消费, 无需提交.
+func (s *memorySession) MarkMessage(*Message, string) {}
+
+func (s *memorySession) Context() context.Context {
+	return s.ctx
+}
+
+type memoryClaim struct {
+	topic *memoryTopic
+	msgs  chan *Message
+}
+
+// forward 将消费组的消息转发给本次会话, 会话结束时关闭Messages.
+func (c *memoryClaim) forward(ctx context.Context, sub chan *Message) {
+	defer close(c.msgs)
+	for {
+		select {
+		case m := <-sub:
+			select {
+			case c.msgs <- m:
+			case <-ctx.Done():
+				// 放回队列, 由下一次会话或同组的其它消费者处理
+				select {
+				case sub <- m:
+				default:
+					log.ZWarn(ctx, "memory mq buffer is full, drop message", nil, "topic", c.topic.name, "offset", m.Offset)
+				}
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *memoryClaim) Topic() string              { return c.topic.name }
+func (c *memoryClaim) Partition() int32           { return 0 }
+func (c *memoryClaim) HighWaterMarkOffset() int64 { return c.topic.highWaterMark() }
+func (c *memoryClaim) Messages() <-chan *Message  { return c.msgs }
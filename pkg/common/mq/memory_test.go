@@ -0,0 +1,112 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+	"google.golang.org/protobuf/proto"
+)
+
+type collectHandler struct {
+	group ConsumerGroup
+	msgs  chan *sdkws.MsgData
+	ops   chan string
+}
+
+func (h *collectHandler) Setup(ConsumerGroupSession) error   { return nil }
+func (h *collectHandler) Cleanup(ConsumerGroupSession) error { return nil }
+
+func (h *collectHandler) ConsumeClaim(sess ConsumerGroupSession, claim ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		var data sdkws.MsgData
+		if err := proto.Unmarshal(msg.Value, &data); err != nil {
+			return err
+		}
+		h.ops <- mcontext.GetOperationID(h.group.GetContextFromMsg(msg))
+		h.msgs <- &data
+		sess.MarkMessage(msg, "")
+	}
+	return nil
+}
+
+func TestMemoryMQ(t *testing.T) {
+	defer func(typ string) { config.Config.MQ.Type = typ }(config.Config.MQ.Type)
+	config.Config.MQ.Type = TypeMemory
+	broker = &memoryBroker{topics: make(map[string]*memoryTopic)}
+	const topic = "test_memory_mq"
+	producer, err := NewProducer(topic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := mcontext.NewCtx("op1")
+	// 启动阶段发送的消息复制给之后订阅的每个消费组
+	if _, offset, err := producer.SendMessage(ctx, "key", &sdkws.MsgData{ClientMsgID: "1"}); err != nil || offset != 0 {
+		t.Fatalf("offset = %d, err = %v", offset, err)
+	}
+	if _, _, err := producer.SendMessage(context.Background(), "key", &sdkws.MsgData{}); err == nil {
+		t.Fatal("expected error for ctx without operationID")
+	}
+
+	consumeCtx, cancel := context.WithCancel(context.Background())
+	handlers := make([]*collectHandler, 2)
+	done := make(chan struct{}, len(handlers))
+	for i, groupID := range []string{"g1", "g2"} {
+		group, err := NewConsumerGroup([]string{topic}, groupID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := &collectHandler{group: group, msgs: make(chan *sdkws.MsgData, 10), ops: make(chan string, 10)}
+		handlers[i] = h
+		go func() {
+			group.RegisterHandleAndConsumer(consumeCtx, h)
+			done <- struct{}{}
+		}()
+		expect(t, h, "1", "op1")
+	}
+	time.Sleep(50 * time.Millisecond)
+	if _, _, err := producer.SendMessage(ctx, "key", &sdkws.MsgData{ClientMsgID: "2"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range handlers {
+		expect(t, h, "2", "op1")
+	}
+
+	cancel()
+	for range handlers {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("consumer group not stopped after ctx canceled")
+		}
+	}
+}
+
+func expect(t *testing.T, h *collectHandler, clientMsgID, operationID string) {
+	t.Helper()
+	select {
+	case msg := <-h.msgs:
+		if op := <-h.ops; msg.ClientMsgID != clientMsgID || op != operationID {
+			t.Fatalf("got %s %s, want %s %s", msg.ClientMsgID, op, clientMsgID, operationID)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("message %s not consumed", clientMsgID)
+	}
+}
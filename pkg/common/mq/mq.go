@@ -0,0 +1,147 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mq 消息队列抽象, msg rpc/msgtransfer/push之间通过它传递消息.
+// 默认使用kafka, 配置mq.type为memory时使用进程内队列, 供所有组件运行在同一进程时(单机部署、集成测试)使用.
+package mq
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
+)
+
+const (
+	TypeKafka  = "kafka"
+	TypeMemory = "memory"
+)
+
+var errEmptyMsg = errors.New("binary msg is empty")
+
+type Header struct {
+	Key   []byte
+	Value []byte
+}
+
+// Message 队列中的一条消息, 字段与kafka消息一致.
+type Message struct {
+	Topic     string
+	Key       []byte
+	Value     []byte
+	Headers   []*Header
+	Partition int32
+	Offset    int64
+	Timestamp time.Time
+}
+
+// Producer 向创建时指定的topic发送消息, 相同key的消息保证顺序.
+type Producer interface {
+	SendMessage(ctx context.Context, key string, msg proto.Message) (partition int32, offset int64, err error)
+}
+
+// ConsumerGroupSession 一次消费会话, MarkMessage标记消息已处理, 由实现决定何时提交.
+type ConsumerGroupSession interface {
+	MarkMessage(msg *Message, metadata string)
+	Context() context.Context
+}
+
+// ConsumerGroupClaim 会话中分配到的一个分区, 会话结束时Messages被关闭.
+type ConsumerGroupClaim interface {
+	Topic() string
+	Partition() int32
+	HighWaterMarkOffset() int64
+	Messages() <-chan *Message
+}
+
+// ConsumerGroupHandler 与sarama.ConsumerGroupHandler语义相同.
+type ConsumerGroupHandler interface {
+	Setup(ConsumerGroupSession) error
+	Cleanup(ConsumerGroupSession) error
+	ConsumeClaim(ConsumerGroupSession, ConsumerGroupClaim) error
+}
+
+type ConsumerGroup interface {
+	// RegisterHandleAndConsumer 持续消费直到ctx取消, 返回前等待handler处理完当前消息.
+	RegisterHandleAndConsumer(ctx context.Context, handler ConsumerGroupHandler)
+	GetContextFromMsg(msg *Message) context.Context
+}
+
+// NewProducer 按mq.type创建topic的生产者.
+func NewProducer(topic string) (Producer, error) {
+	switch config.Config.MQ.Type {
+	case "", TypeKafka:
+		return newKafkaProducer(topic)
+	case TypeMemory:
+		return newMemoryProducer(topic), nil
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown mq type " + config.Config.MQ.Type)
+	}
+}
+
+// NewConsumerGroup 按mq.type创建消费组, 新消费组从最新的消息开始消费.
+func NewConsumerGroup(topics []string, groupID string) (ConsumerGroup, error) {
+	switch config.Config.MQ.Type {
+	case "", TypeKafka:
+		return newKafkaConsumerGroup(topics, groupID)
+	case TypeMemory:
+		return newMemoryConsumerGroup(topics, groupID), nil
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown mq type " + config.Config.MQ.Type)
+	}
+}
+
+// marshal 生产者共用的消息编码, 空消息和缺少operationID的ctx返回错误.
+func marshal(ctx context.Context, key string, msg proto.Message) ([]byte, []*Header, error) {
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, nil, utils.Wrap(err, "mq proto Marshal err")
+	}
+	if len(key) == 0 || len(value) == 0 {
+		return nil, nil, utils.Wrap(errEmptyMsg, "")
+	}
+	headers, err := GetMQHeaderWithContext(ctx)
+	if err != nil {
+		return nil, nil, utils.Wrap(err, "")
+	}
+	return value, headers, nil
+}
+
+func GetMQHeaderWithContext(ctx context.Context) ([]*Header, error) {
+	operationID, opUserID, platform, connID, err := mcontext.GetCtxInfos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []*Header{
+		{Key: []byte(constant.OperationID), Value: []byte(operationID)},
+		{Key: []byte(constant.OpUserID), Value: []byte(opUserID)},
+		{Key: []byte(constant.OpUserPlatform), Value: []byte(platform)},
+		{Key: []byte(constant.ConnID), Value: []byte(connID)},
+	}, nil
+}
+
+func GetContextWithMQHeader(headers []*Header) context.Context {
+	var values []string
+	for _, header := range headers {
+		values = append(values, string(header.Value))
+	}
+	return mcontext.WithMustInfoCtx(values)
+}
@@ -44,6 +44,10 @@ var (
 	MsgRecvTotalCounter          prometheus.Counter
 	GetNewestSeqTotalCounter     prometheus.Counter
 	PullMsgBySeqListTotalCounter prometheus.Counter
+	// 网关限流
+	MsgGatewayRateLimitedCounter        prometheus.Counter
+	MsgGatewayConnectRateLimitedCounter prometheus.Counter
+	MsgGatewayRateLimitKickedCounter    prometheus.Counter
 
 	SingleChatMsgRecvSuccessCounter         prometheus.Counter
 	GroupChatMsgRecvSuccessCounter          prometheus.Counter
@@ -468,3 +472,33 @@ func NewConversationCreateFailedCounter() {
 		Help: "The number of conversation failed pushed",
 	})
 }
+
+func NewMsgGatewayRateLimitedCounter() {
+	if MsgGatewayRateLimitedCounter != nil {
+		return
+	}
+	MsgGatewayRateLimitedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "msg_gateway_rate_limited",
+		Help: "The number of websocket requests rejected by rate limit",
+	})
+}
+
+func NewMsgGatewayConnectRateLimitedCounter() {
+	if MsgGatewayConnectRateLimitedCounter != nil {
+		return
+	}
+	MsgGatewayConnectRateLimitedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "msg_gateway_connect_rate_limited",
+		Help: "The number of websocket connections rejected by rate limit",
+	})
+}
+
+func NewMsgGatewayRateLimitKickedCounter() {
+	if MsgGatewayRateLimitKickedCounter != nil {
+		return
+	}
+	MsgGatewayRateLimitKickedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "msg_gateway_rate_limit_kicked",
+		Help: "The number of websocket connections closed for exceeding rate limit repeatedly",
+	})
+}
@@ -28,9 +28,10 @@ import (
 
 func StartPrometheusSrv(prometheusPort int) error {
 	if config.Config.Prometheus.Enable {
-		http.Handle("/metrics", promhttp.Handler())
-		err := http.ListenAndServe(":"+strconv.Itoa(prometheusPort), nil)
-		return err
+		// 每个端口使用独立的mux, 同一进程中启动多个服务时不会重复注册/metrics
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		return http.ListenAndServe(":"+strconv.Itoa(prometheusPort), mux)
 	}
 	return nil
 }
@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package shutdown 统一处理进程退出信号, 使rpc服务、网关和kafka消费者在滚动发布时可以优雅退出.
+package shutdown
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+)
+
+var (
+	once   sync.Once
+	ctx    context.Context
+	cancel context.CancelFunc
+	tasks  sync.WaitGroup
+)
+
+func initSignal() {
+	ctx, cancel = context.WithCancel(context.Background())
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		<-ch
+		signal.Stop(ch)
+		cancel()
+	}()
+}
+
+// Context 返回收到SIGTERM或SIGINT后被取消的ctx, 进程内所有组件共用同一个.
+func Context() context.Context {
+	once.Do(initSignal)
+	return ctx
+}
+
+// Go 启动需要在进程退出前完成的后台任务, fn应在Context取消后尽快返回.
+func Go(fn func()) {
+	tasks.Add(1)
+	go func() {
+		defer tasks.Done()
+		fn()
+	}()
+}
+
+// Wait 等待Go启动的任务全部返回, 超过timeout后放弃等待并返回false.
+func Wait(timeout time.Duration) bool {
+	return WaitFunc(tasks.Wait, timeout)
+}
+
+// WaitFunc 等待fn返回, 超过timeout后放弃等待并返回false, fn仍在后台继续执行.
+func WaitFunc(fn func(), timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		fn()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// DrainTimeout 退出时等待进行中请求完成的最长时间.
+func DrainTimeout() time.Duration {
+	if config.Config.Shutdown.DrainTimeout <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(config.Config.Shutdown.DrainTimeout) * time.Second
+}
@@ -49,6 +49,7 @@ const (
 	NoPermissionError   = 1002 // 权限不足
 	DuplicateKeyError   = 1003
 	RecordNotFoundError = 1004 // 记录不存在
+	RateLimitExceeded   = 1005 // 请求过于频繁
 
 	// 账号错误码.
 	UserIDNotFoundError    = 1101 // UserID不存在 或未注册
@@ -73,6 +74,8 @@ const (
 	MutedInGroup          = 1402 // 群成员被禁言
 	MutedGroup            = 1403 // 群被禁言
 	MsgAlreadyRevoke      = 1404 // 消息已撤回
+	MsgArchived           = 1405 // 消息已归档, 只读
+	MsgSending            = 1406 // 相同clientMsgID的消息正在发送
 
 	// token错误码.
 	TokenExpiredError     = 1501
@@ -86,6 +89,7 @@ const (
 	// 长连接网关错误码.
 	ConnOverMaxNumLimit = 1601
 	ConnArgsErr         = 1602
+	GatewayDraining     = 1603 // 网关正在下线, 客户端需重连其他网关
 
 	// S3错误码.
 	FileUploadedExpiredError = 1701 // 上传过期
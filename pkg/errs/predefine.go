@@ -29,6 +29,8 @@ var (
 
 	ErrRecordNotFound = NewCodeError(RecordNotFoundError, "RecordNotFoundError")
 
+	ErrRateLimitExceeded = NewCodeError(RateLimitExceeded, "RateLimitExceeded")
+
 	ErrNotInGroupYet       = NewCodeError(NotInGroupYetError, "NotInGroupYetError")
 	ErrDismissedAlready    = NewCodeError(DismissedAlreadyError, "DismissedAlreadyError")
 	ErrRegisteredAlready   = NewCodeError(RegisteredAlreadyError, "RegisteredAlreadyError")
@@ -55,10 +57,14 @@ var (
 	ErrMutedInGroup     = NewCodeError(MutedInGroup, "MutedInGroup")
 	ErrMutedGroup       = NewCodeError(MutedGroup, "MutedGroup")
 	ErrMsgAlreadyRevoke = NewCodeError(MsgAlreadyRevoke, "MsgAlreadyRevoke")
+	ErrMsgArchived      = NewCodeError(MsgArchived, "MsgArchived")
+	ErrMsgSending       = NewCodeError(MsgSending, "MsgSending")
 
 	ErrConnOverMaxNumLimit = NewCodeError(ConnOverMaxNumLimit, "ConnOverMaxNumLimit")
 
 	ErrConnArgsErr = NewCodeError(ConnArgsErr, "args err, need token, sendID, platformID")
 
+	ErrGatewayDraining = NewCodeError(GatewayDraining, "GatewayDraining")
+
 	ErrFileUploadedExpired = NewCodeError(FileUploadedExpiredError, "FileUploadedExpiredError")
 )
@@ -14,7 +14,12 @@
 
 package msg
 
-import "github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+import (
+	"strings"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
+)
 
 func (x *GetMaxAndMinSeqReq) Check() error {
 	if x.UserID == "" {
@@ -33,6 +38,95 @@ func (x *SendMsgReq) Check() error {
 	return nil
 }
 
+func (x *ScheduleMsgReq) Check() error {
+	if x.MsgData == nil {
+		return errs.ErrArgs.Wrap("MsgData is empty")
+	}
+	if err := x.MsgData.Check(); err != nil {
+		return err
+	}
+	if x.SendTime <= 0 {
+		return errs.ErrArgs.Wrap("sendTime is invalid")
+	}
+	return nil
+}
+
+func (x *GetScheduledMsgsReq) Check() error {
+	if x.SendID == "" {
+		return errs.ErrArgs.Wrap("sendID is empty")
+	}
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *SetScheduledMsgReq) Check() error {
+	if x.ScheduledMsgID == "" {
+		return errs.ErrArgs.Wrap("scheduledMsgID is empty")
+	}
+	if x.SendID == "" {
+		return errs.ErrArgs.Wrap("sendID is empty")
+	}
+	if x.SendTime == nil && x.Content == nil {
+		return errs.ErrArgs.Wrap("nothing to update")
+	}
+	if x.Content != nil && x.Content.Value == "" {
+		return errs.ErrArgs.Wrap("content is empty")
+	}
+	return nil
+}
+
+func (x *CancelScheduledMsgReq) Check() error {
+	if x.ScheduledMsgID == "" {
+		return errs.ErrArgs.Wrap("scheduledMsgID is empty")
+	}
+	if x.SendID == "" {
+		return errs.ErrArgs.Wrap("sendID is empty")
+	}
+	return nil
+}
+
+func checkRetentionTarget(targetType int32, targetID string) error {
+	if targetType != constant.RetentionTargetGroup && targetType != constant.RetentionTargetUser {
+		return errs.ErrArgs.Wrap("targetType is invalid")
+	}
+	if targetID == "" {
+		return errs.ErrArgs.Wrap("targetID is empty")
+	}
+	return nil
+}
+
+func (x *SetRetentionPolicyReq) Check() error {
+	if err := checkRetentionTarget(x.TargetType, x.TargetID); err != nil {
+		return err
+	}
+	if x.MaxAge < 0 {
+		return errs.ErrArgs.Wrap("maxAge is invalid")
+	}
+	if x.MaxMsgNum < 0 {
+		return errs.ErrArgs.Wrap("maxMsgNum is invalid")
+	}
+	return nil
+}
+
+func (x *DeleteRetentionPolicyReq) Check() error {
+	return checkRetentionTarget(x.TargetType, x.TargetID)
+}
+
+func (x *GetRetentionPoliciesReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	return nil
+}
+
 func (x *SetSendMsgStatusReq) Check() error {
 	if x.Status < 0 || x.Status > 3 {
 		return errs.ErrArgs.Wrap("status is invalid")
@@ -116,6 +210,210 @@ func (x *RevokeMsgReq) Check() error {
 	return nil
 }
 
+func (x *EditMsgReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errs.ErrArgs.Wrap("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if x.Content == "" {
+		return errs.ErrArgs.Wrap("content is empty")
+	}
+	return nil
+}
+
+// checkReactionTypeKey rejects keys that can not be used as a mongo field name.
+func checkReactionTypeKey(typeKey string) error {
+	if typeKey == "" {
+		return errs.ErrArgs.Wrap("typeKey is empty")
+	}
+	if strings.Contains(typeKey, ".") || strings.HasPrefix(typeKey, "$") {
+		return errs.ErrArgs.Wrap("typeKey can not contain '.' or start with '$'")
+	}
+	return nil
+}
+
+func (x *SetMessageReactionExtensionsReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errs.ErrArgs.Wrap("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if len(x.ReactionExtensions) == 0 {
+		return errs.ErrArgs.Wrap("reactionExtensions is empty")
+	}
+	for _, kv := range x.ReactionExtensions {
+		if kv == nil {
+			return errs.ErrArgs.Wrap("reactionExtensions has nil value")
+		}
+		if err := checkReactionTypeKey(kv.TypeKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *DeleteMessageReactionExtensionsReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.Seq < 1 {
+		return errs.ErrArgs.Wrap("seq is invalid")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if len(x.TypeKeys) == 0 {
+		return errs.ErrArgs.Wrap("typeKeys is empty")
+	}
+	for _, typeKey := range x.TypeKeys {
+		if err := checkReactionTypeKey(typeKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *GetMessagesReactionExtensionsReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if len(x.Seqs) == 0 {
+		return errs.ErrArgs.Wrap("seqs is empty")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	for _, seq := range x.Seqs {
+		if seq < 1 {
+			return errs.ErrArgs.Wrap("seqs has invalid value")
+		}
+	}
+	return nil
+}
+
+func (x *PinMsgReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if x.Seq < 1 {
+		return errs.ErrArgs.Wrap("seq is invalid")
+	}
+	return nil
+}
+
+func (x *UnpinMsgReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if x.Seq < 1 {
+		return errs.ErrArgs.Wrap("seq is invalid")
+	}
+	return nil
+}
+
+func (x *GetPinnedMsgsReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetGroupMsgReadStatusReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if x.Seq < 1 {
+		return errs.ErrArgs.Wrap("seq is invalid")
+	}
+	return nil
+}
+
+func (x *MsgDeliveryAckReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if len(x.ServerMsgIDs) == 0 {
+		return errs.ErrArgs.Wrap("serverMsgIDs is empty")
+	}
+	return nil
+}
+
+func (x *GetMsgDeliveryStatusReq) Check() error {
+	if x.ServerMsgID == "" {
+		return errs.ErrArgs.Wrap("serverMsgID is empty")
+	}
+	return nil
+}
+
+func (x *GetThreadsInfoReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if len(x.RootSeqs) == 0 {
+		return errs.ErrArgs.Wrap("rootSeqs is empty")
+	}
+	return nil
+}
+
+func (x *PullThreadMsgsReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if x.RootSeq < 1 {
+		return errs.ErrArgs.Wrap("rootSeq is invalid")
+	}
+	if x.Begin < 1 || x.End < x.Begin {
+		return errs.ErrArgs.Wrap("begin or end is invalid")
+	}
+	if x.End-x.Begin >= 1000 {
+		return errs.ErrArgs.Wrap("too many seqs, at most 1000")
+	}
+	return nil
+}
+
+func (x *SetThreadHasReadSeqReq) Check() error {
+	if x.ConversationID == "" {
+		return errs.ErrArgs.Wrap("conversationID is empty")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if x.RootSeq < 1 {
+		return errs.ErrArgs.Wrap("rootSeq is invalid")
+	}
+	if x.HasReadSeq < 0 {
+		return errs.ErrArgs.Wrap("hasReadSeq is invalid")
+	}
+	return nil
+}
+
 func (x *MarkMsgsAsReadReq) Check() error {
 	if x.ConversationID == "" {
 		return errs.ErrArgs.Wrap("conversationID is empty")
@@ -225,6 +225,99 @@ func (*DelUserPushTokenResp) Descriptor() ([]byte, []int) {
 	return file_push_push_proto_rawDescGZIP(), []int{3}
 }
 
+type PushEphemeralReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event   *sdkws.EphemeralEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
+	UserIDs []string              `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *PushEphemeralReq) Reset() {
+	*x = PushEphemeralReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_push_push_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushEphemeralReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEphemeralReq) ProtoMessage() {}
+
+func (x *PushEphemeralReq) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEphemeralReq.ProtoReflect.Descriptor instead.
+func (*PushEphemeralReq) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PushEphemeralReq) GetEvent() *sdkws.EphemeralEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *PushEphemeralReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type PushEphemeralResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushEphemeralResp) Reset() {
+	*x = PushEphemeralResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_push_push_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushEphemeralResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEphemeralResp) ProtoMessage() {}
+
+func (x *PushEphemeralResp) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEphemeralResp.ProtoReflect.Descriptor instead.
+func (*PushEphemeralResp) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{5}
+}
+
 var File_push_push_proto protoreflect.FileDescriptor
 
 var file_push_push_proto_rawDesc = []byte{
@@ -245,23 +338,37 @@ var file_push_push_proto_rawDesc = []byte{
 	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
 	0x49, 0x44, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x32, 0xbf, 0x01, 0x0a, 0x0e, 0x50,
-	0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a,
-	0x07, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x12, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73,
-	0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
-	0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x42, 0x34, 0x5a, 0x32,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x2d, 0x49, 0x4d, 0x2d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x75,
-	0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x66, 0x0a, 0x10, 0x50, 0x75,
+	0x73, 0x68, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x38,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b,
+	0x77, 0x73, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65,
+	0x72, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x32, 0x9b, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x50, 0x75,
+	0x73, 0x68, 0x4d, 0x73, 0x67, 0x12, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x0d, 0x50, 0x75, 0x73,
+	0x68, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x4f, 0x70,
+	0x65, 0x6e, 0x2d, 0x49, 0x4d, 0x2d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -276,25 +383,31 @@ func file_push_push_proto_rawDescGZIP() []byte {
 	return file_push_push_proto_rawDescData
 }
 
-var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_push_push_proto_goTypes = []interface{}{
 	(*PushMsgReq)(nil),           // 0: OpenIMServer.push.PushMsgReq
 	(*PushMsgResp)(nil),          // 1: OpenIMServer.push.PushMsgResp
 	(*DelUserPushTokenReq)(nil),  // 2: OpenIMServer.push.DelUserPushTokenReq
 	(*DelUserPushTokenResp)(nil), // 3: OpenIMServer.push.DelUserPushTokenResp
-	(*sdkws.MsgData)(nil),        // 4: OpenIMServer.sdkws.MsgData
+	(*PushEphemeralReq)(nil),     // 4: OpenIMServer.push.PushEphemeralReq
+	(*PushEphemeralResp)(nil),    // 5: OpenIMServer.push.PushEphemeralResp
+	(*sdkws.MsgData)(nil),        // 6: OpenIMServer.sdkws.MsgData
+	(*sdkws.EphemeralEvent)(nil), // 7: OpenIMServer.sdkws.EphemeralEvent
 }
 var file_push_push_proto_depIdxs = []int32{
-	4, // 0: OpenIMServer.push.PushMsgReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
-	0, // 1: OpenIMServer.push.PushMsgService.PushMsg:input_type -> OpenIMServer.push.PushMsgReq
-	2, // 2: OpenIMServer.push.PushMsgService.DelUserPushToken:input_type -> OpenIMServer.push.DelUserPushTokenReq
-	1, // 3: OpenIMServer.push.PushMsgService.PushMsg:output_type -> OpenIMServer.push.PushMsgResp
-	3, // 4: OpenIMServer.push.PushMsgService.DelUserPushToken:output_type -> OpenIMServer.push.DelUserPushTokenResp
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: OpenIMServer.push.PushMsgReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	7, // 1: OpenIMServer.push.PushEphemeralReq.event:type_name -> OpenIMServer.sdkws.EphemeralEvent
+	0, // 2: OpenIMServer.push.PushMsgService.PushMsg:input_type -> OpenIMServer.push.PushMsgReq
+	2, // 3: OpenIMServer.push.PushMsgService.DelUserPushToken:input_type -> OpenIMServer.push.DelUserPushTokenReq
+	4, // 4: OpenIMServer.push.PushMsgService.PushEphemeral:input_type -> OpenIMServer.push.PushEphemeralReq
+	1, // 5: OpenIMServer.push.PushMsgService.PushMsg:output_type -> OpenIMServer.push.PushMsgResp
+	3, // 6: OpenIMServer.push.PushMsgService.DelUserPushToken:output_type -> OpenIMServer.push.DelUserPushTokenResp
+	5, // 7: OpenIMServer.push.PushMsgService.PushEphemeral:output_type -> OpenIMServer.push.PushEphemeralResp
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_push_push_proto_init() }
@@ -351,6 +464,30 @@ func file_push_push_proto_init() {
 				return nil
 			}
 		}
+		file_push_push_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushEphemeralReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_push_push_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushEphemeralResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -358,7 +495,7 @@ func file_push_push_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_push_push_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -386,6 +523,8 @@ const _ = grpc.SupportPackageIsVersion6
 type PushMsgServiceClient interface {
 	PushMsg(ctx context.Context, in *PushMsgReq, opts ...grpc.CallOption) (*PushMsgResp, error)
 	DelUserPushToken(ctx context.Context, in *DelUserPushTokenReq, opts ...grpc.CallOption) (*DelUserPushTokenResp, error)
+	// 只推送给在线的用户
+	PushEphemeral(ctx context.Context, in *PushEphemeralReq, opts ...grpc.CallOption) (*PushEphemeralResp, error)
 }
 
 type pushMsgServiceClient struct {
@@ -414,10 +553,21 @@ func (c *pushMsgServiceClient) DelUserPushToken(ctx context.Context, in *DelUser
 	return out, nil
 }
 
+func (c *pushMsgServiceClient) PushEphemeral(ctx context.Context, in *PushEphemeralReq, opts ...grpc.CallOption) (*PushEphemeralResp, error) {
+	out := new(PushEphemeralResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.push.PushMsgService/PushEphemeral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PushMsgServiceServer is the server API for PushMsgService service.
 type PushMsgServiceServer interface {
 	PushMsg(context.Context, *PushMsgReq) (*PushMsgResp, error)
 	DelUserPushToken(context.Context, *DelUserPushTokenReq) (*DelUserPushTokenResp, error)
+	// 只推送给在线的用户
+	PushEphemeral(context.Context, *PushEphemeralReq) (*PushEphemeralResp, error)
 }
 
 // UnimplementedPushMsgServiceServer can be embedded to have forward compatible implementations.
@@ -430,6 +580,9 @@ func (*UnimplementedPushMsgServiceServer) PushMsg(context.Context, *PushMsgReq)
 func (*UnimplementedPushMsgServiceServer) DelUserPushToken(context.Context, *DelUserPushTokenReq) (*DelUserPushTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelUserPushToken not implemented")
 }
+func (*UnimplementedPushMsgServiceServer) PushEphemeral(context.Context, *PushEphemeralReq) (*PushEphemeralResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushEphemeral not implemented")
+}
 
 func RegisterPushMsgServiceServer(s *grpc.Server, srv PushMsgServiceServer) {
 	s.RegisterService(&_PushMsgService_serviceDesc, srv)
@@ -471,6 +624,24 @@ func _PushMsgService_DelUserPushToken_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PushMsgService_PushEphemeral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushEphemeralReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushMsgServiceServer).PushEphemeral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.push.PushMsgService/PushEphemeral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushMsgServiceServer).PushEphemeral(ctx, req.(*PushEphemeralReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PushMsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "OpenIMServer.push.PushMsgService",
 	HandlerType: (*PushMsgServiceServer)(nil),
@@ -483,6 +654,10 @@ var _PushMsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelUserPushToken",
 			Handler:    _PushMsgService_DelUserPushToken_Handler,
 		},
+		{
+			MethodName: "PushEphemeral",
+			Handler:    _PushMsgService_PushEphemeral_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "push/push.proto",
@@ -22,6 +22,7 @@ import (
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/constant"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/tokenverify"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/discoveryregistry"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/errs"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/group"
@@ -157,6 +158,21 @@ func (g *GroupRpcClient) GetOwnerAndAdminInfos(
 	return resp.Members, nil
 }
 
+// CheckGroupAdmin checks the same way as the group rpc does, app managers always pass.
+func (g *GroupRpcClient) CheckGroupAdmin(ctx context.Context, groupID string, userID string) error {
+	if tokenverify.IsAppManagerUid(ctx) {
+		return nil
+	}
+	member, err := g.GetGroupMemberCache(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if !(member.RoleLevel == constant.GroupOwner || member.RoleLevel == constant.GroupAdmin) {
+		return errs.ErrNoPermission.Wrap("no group owner or admin")
+	}
+	return nil
+}
+
 func (g *GroupRpcClient) GetOwnerInfo(ctx context.Context, groupID string) (*sdkws.GroupMemberFullInfo, error) {
 	resp, err := g.Client.GetGroupMemberRoleLevel(ctx, &group.GetGroupMemberRoleLevelReq{
 		GroupID:    groupID,
@@ -207,3 +223,36 @@ func (g *GroupRpcClient) DismissGroup(ctx context.Context, groupID string) error
 	})
 	return err
 }
+
+// GetJoinedGroupIDs 分页拉取userID加入的全部群ID.
+func (g *GroupRpcClient) GetJoinedGroupIDs(ctx context.Context, userID string) ([]string, error) {
+	const showNumber = 1000
+	var groupIDs []string
+	for pageNumber := int32(1); ; pageNumber++ {
+		resp, err := g.Client.GetJoinedGroupList(ctx, &group.GetJoinedGroupListReq{
+			FromUserID: userID,
+			Pagination: &sdkws.RequestPagination{PageNumber: pageNumber, ShowNumber: showNumber},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, groupInfo := range resp.Groups {
+			groupIDs = append(groupIDs, groupInfo.GroupID)
+		}
+		if len(resp.Groups) < showNumber || len(groupIDs) >= int(resp.Total) {
+			return groupIDs, nil
+		}
+	}
+}
+
+// InAnyGroup userID是否在groupIDs中任意一个群里.
+func (g *GroupRpcClient) InAnyGroup(ctx context.Context, userID string, groupIDs []string) (bool, error) {
+	if len(groupIDs) == 0 {
+		return false, nil
+	}
+	resp, err := g.Client.GetUserInGroupMembers(ctx, &group.GetUserInGroupMembersReq{UserID: userID, GroupIDs: groupIDs})
+	if err != nil {
+		return false, err
+	}
+	return len(resp.Members) > 0, nil
+}
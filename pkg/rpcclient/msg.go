@@ -72,8 +72,13 @@ func newContentTypeConf() map[int32]config.NotificationConf {
 		constant.ConversationPrivateChatNotification: config.Config.Notification.ConversationSetPrivate,
 		// msg
 		constant.MsgRevokeNotification:  {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.MsgEditNotification:    {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
 		constant.HasReadReceipt:         {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
 		constant.DeleteMsgsNotification: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.MsgPinnedNotification:  {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		// reaction
+		constant.ReactionMessageModifier: {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
+		constant.ReactionMessageDeleter:  {IsSendMsg: false, ReliabilityLevel: constant.ReliableNotificationNoMsg},
 	}
 }
 
@@ -157,6 +162,24 @@ func (m *MessageRpcClient) PullMessageBySeqList(ctx context.Context, req *sdkws.
 	return resp, err
 }
 
+func (m *MessageRpcClient) MsgDeliveryAck(ctx context.Context, req *msg.MsgDeliveryAckReq) (*msg.MsgDeliveryAckResp, error) {
+	resp, err := m.Client.MsgDeliveryAck(ctx, req)
+	return resp, err
+}
+
+func (m *MessageRpcClient) SendEphemeral(ctx context.Context, req *msg.SendEphemeralReq) (*msg.SendEphemeralResp, error) {
+	resp, err := m.Client.SendEphemeral(ctx, req)
+	return resp, err
+}
+
+func (m *MessageRpcClient) GetConversationsHasReadAndMaxSeq(ctx context.Context, userID string) (map[string]*msg.Seqs, error) {
+	resp, err := m.Client.GetConversationsHasReadAndMaxSeq(ctx, &msg.GetConversationsHasReadAndMaxSeqReq{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Seqs, nil
+}
+
 func (m *MessageRpcClient) GetConversationMaxSeq(ctx context.Context, conversationID string) (int64, error) {
 	resp, err := m.Client.GetConversationMaxSeq(ctx, &msg.GetConversationMaxSeqReq{ConversationID: conversationID})
 	if err != nil {
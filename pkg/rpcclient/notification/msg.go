@@ -39,6 +39,27 @@ func (m *MsgNotificationSender) UserDeleteMsgsNotification(ctx context.Context,
 	return m.Notification(ctx, userID, userID, constant.DeleteMsgsNotification, &tips)
 }
 
+func (m *MsgNotificationSender) MsgPinnedNotification(
+	ctx context.Context,
+	opUserID, recvID, conversationID string,
+	sessionType int32,
+	clientMsgID string,
+	seq int64,
+	isPinned bool,
+	pinTime int64,
+) error {
+	tips := &sdkws.PinnedMsgTips{
+		OpUserID:       opUserID,
+		ConversationID: conversationID,
+		SessionType:    sessionType,
+		ClientMsgID:    clientMsgID,
+		Seq:            seq,
+		IsPinned:       isPinned,
+		PinTime:        pinTime,
+	}
+	return m.NotificationWithSesstionType(ctx, opUserID, recvID, constant.MsgPinnedNotification, sessionType, tips)
+}
+
 func (m *MsgNotificationSender) MarkAsReadNotification(ctx context.Context, conversationID string, sesstionType int32, sendID, recvID string, seqs []int64, hasReadSeq int64) error {
 	tips := &sdkws.MarkAsReadTips{
 		MarkAsReadUserID: sendID,
@@ -22,6 +22,7 @@ import (
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/discoveryregistry"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/push"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
 )
 
 type Push struct {
@@ -54,3 +55,8 @@ func (p *PushRpcClient) DelUserPushToken(
 ) (*push.DelUserPushTokenResp, error) {
 	return p.Client.DelUserPushToken(ctx, req)
 }
+
+func (p *PushRpcClient) PushEphemeral(ctx context.Context, event *sdkws.EphemeralEvent, userIDs []string) error {
+	_, err := p.Client.PushEphemeral(ctx, &push.PushEphemeralReq{Event: event, UserIDs: userIDs})
+	return err
+}
@@ -0,0 +1,58 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/discoveryregistry"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/rtc"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/proto/sdkws"
+)
+
+type Rtc struct {
+	conn   grpc.ClientConnInterface
+	Client rtc.RtcServiceClient
+	discov discoveryregistry.SvcDiscoveryRegistry
+}
+
+func NewRtc(discov discoveryregistry.SvcDiscoveryRegistry) *Rtc {
+	conn, err := discov.GetConn(context.Background(), config.Config.RpcRegisterName.OpenImRtcName)
+	if err != nil {
+		panic(err)
+	}
+	return &Rtc{discov: discov, conn: conn, Client: rtc.NewRtcServiceClient(conn)}
+}
+
+type RtcRpcClient Rtc
+
+func NewRtcRpcClient(discov discoveryregistry.SvcDiscoveryRegistry) RtcRpcClient {
+	return RtcRpcClient(*NewRtc(discov))
+}
+
+func (r *RtcRpcClient) SignalMessageAssemble(ctx context.Context, req *sdkws.SignalReq) (*sdkws.SignalResp, error) {
+	resp, err := r.Client.SignalMessageAssemble(ctx, &rtc.SignalMessageAssembleReq{SignalReq: req})
+	if err != nil {
+		return nil, err
+	}
+	return resp.SignalResp, nil
+}
+
+func (r *RtcRpcClient) SignalGetPendingInvitations(ctx context.Context, req *rtc.SignalGetPendingInvitationsReq) (*rtc.SignalGetPendingInvitationsResp, error) {
+	return r.Client.SignalGetPendingInvitations(ctx, req)
+}
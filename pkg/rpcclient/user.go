@@ -70,6 +70,14 @@ func (u *UserRpcClient) GetUsersInfo(ctx context.Context, userIDs []string) ([]*
 	return resp.UsersInfo, nil
 }
 
+func (u *UserRpcClient) GetUsersPresence(ctx context.Context, userIDs []string) ([]*sdkws.UserPresence, error) {
+	resp, err := u.Client.GetUsersPresence(ctx, &user.GetUsersPresenceReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Presences, nil
+}
+
 func (u *UserRpcClient) GetUserInfo(ctx context.Context, userID string) (*sdkws.UserInfo, error) {
 	users, err := u.GetUsersInfo(ctx, []string{userID})
 	if err != nil {
@@ -26,9 +26,11 @@ import (
 
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/config"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/log"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mcontext"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/mw"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/network"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/prome"
+	"github.com/OpenIMSDK/Open-IM-Server/pkg/common/shutdown"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/discoveryregistry"
 	openKeeper "github.com/OpenIMSDK/Open-IM-Server/pkg/discoveryregistry/zookeeper"
 	"github.com/OpenIMSDK/Open-IM-Server/pkg/utils"
@@ -108,5 +110,29 @@ func Start(
 			}
 		}
 	}()
+	go func() {
+		<-shutdown.Context().Done()
+		gracefulStop(rpcRegisterName, zkClient, srv)
+	}()
 	return utils.Wrap1(srv.Serve(listener))
 }
+
+// gracefulStop 先从服务发现摘除, 再等待本进程的后台任务(websocket连接、kafka消费)退出, 最后等待进行中的rpc完成.
+func gracefulStop(rpcRegisterName string, zkClient discoveryregistry.SvcDiscoveryRegistry, srv *grpc.Server) {
+	ctx := mcontext.NewCtx("shutdown_" + rpcRegisterName)
+	log.ZInfo(ctx, "rpc server shutting down", "rpcRegisterName", rpcRegisterName)
+	if err := zkClient.UnRegister(); err != nil {
+		log.ZError(ctx, "unregister from service discovery failed", err)
+	}
+	// 两个阶段共用一个超时时间
+	timeout := shutdown.DrainTimeout()
+	deadline := time.Now().Add(timeout)
+	if !shutdown.Wait(timeout) {
+		log.ZWarn(ctx, "background tasks not finished before drain timeout", nil, "timeout", timeout)
+	}
+	if !shutdown.WaitFunc(srv.GracefulStop, time.Until(deadline)) {
+		log.ZWarn(ctx, "in-flight rpc not finished before drain timeout", nil, "timeout", timeout)
+		srv.Stop()
+	}
+	log.ZInfo(ctx, "rpc server stopped", "rpcRegisterName", rpcRegisterName)
+}
@@ -179,3 +179,8 @@ func (o Options) IsSenderNotificationPush() bool {
 func (o Options) IsReactionFromCache() bool {
 	return o.Is(constant.IsReactionFromCache)
 }
+
+// IsDeliveryReceipt 需要发送者显式开启, 未设置时不跟踪投递状态.
+func (o Options) IsDeliveryReceipt() bool {
+	return o[constant.IsDeliveryReceipt]
+}
@@ -367,6 +367,11 @@ func GetNotificationConversationIDByConversationID(conversationID string) string
 	return ""
 }
 
+// GetThreadConversationID returns the id used to keep per-user has read seq of a thread.
+func GetThreadConversationID(conversationID string, rootSeq int64) string {
+	return "t_" + conversationID + "_" + strconv.FormatInt(rootSeq, 10)
+}
+
 func GetSelfNotificationConversationID(userID string) string {
 	return "n_" + userID + "_" + userID
 }
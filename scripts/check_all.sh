@@ -40,6 +40,7 @@ service_port_name=(
   openImPushPort
   openImConversationPort
   openImThirdPort
+  openImRtcPort
 )
 for i in ${service_port_name[*]}; do
   list=$(cat $config_path | grep -w ${i} | awk -F '[:]' '{print $NF}')
@@ -45,6 +45,7 @@ openim::golang::server_targets() {
     openim-rpc-friend
     openim-rpc-group
     openim-rpc-msg
+    openim-rpc-rtc
     openim-rpc-third
     openim-rpc-user
   )
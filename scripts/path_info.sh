@@ -95,6 +95,7 @@ service_source_root=(
   "$OPENIM_ROOT/cmd/openim-rpc/openim-rpc-auth/"
   "$OPENIM_ROOT/cmd/openim-rpc/openim-rpc-conversation/"
   "$OPENIM_ROOT/cmd/openim-rpc/openim-rpc-third/"
+  "$OPENIM_ROOT/cmd/openim-rpc/openim-rpc-rtc/"
   "$OPENIM_ROOT/cmd/openim-crontask"
   "${msg_gateway_source_root}"
   "${msg_transfer_source_root}"
@@ -114,6 +115,7 @@ service_names=(
   "openim-rpc-auth"
   "openim-rpc-conversation"
   "openim-rpc-third"
+  "openim-rpc-rtc"
   "openim-crontask"
   "${openim_msggateway}"
   "${openim_msgtransfer}"
@@ -44,6 +44,7 @@ service_filename=(
   ${msg_name}
   openim-rpc-conversation
   openim-rpc-third
+  openim-rpc-rtc
 )
 
 #service config port name
@@ -58,6 +59,7 @@ service_port_name=(
   openImMessagePort
   openImConversationPort
   openImThirdPort
+  openImRtcPort
 )
 
 service_prometheus_port_name=(
@@ -71,6 +73,7 @@ service_prometheus_port_name=(
   messagePrometheusPort
   conversationPrometheusPort
   thirdPrometheusPort
+  rtcPrometheusPort
 )
 
 for ((i = 0; i < ${#service_filename[*]}; i++)); do